@@ -1,6 +1,6 @@
 module github.com/bobg/go-generics/v4
 
-go 1.23
+go 1.24
 
 require golang.org/x/sync v0.8.0
 
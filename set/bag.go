@@ -0,0 +1,200 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Bag is a multiset of elements of type T:
+// a set in which each member has a positive count.
+//
+// The zero value of Bag is not safe for use.
+// Create one with NewBag instead.
+type Bag[T comparable] map[T]int
+
+// NewBag produces a new bag containing the given values.
+// A value appearing more than once is counted once per appearance.
+func NewBag[T comparable](vals ...T) Bag[T] {
+	b := make(Bag[T])
+	for _, val := range vals {
+		b.Add(val, 1)
+	}
+	return b
+}
+
+// CollectBag collects the members of the given sequence into a new bag,
+// counting each appearance.
+func CollectBag[T comparable](inp iter.Seq[T]) Bag[T] {
+	b := make(Bag[T])
+	for val := range inp {
+		b.Add(val, 1)
+	}
+	return b
+}
+
+// Add adds n copies of val to the bag.
+// If n is not positive, Add does nothing.
+func (b Bag[T]) Add(val T, n int) {
+	if n <= 0 {
+		return
+	}
+	b[val] += n
+}
+
+// Remove removes up to n copies of val from the bag.
+// When the count of val reaches zero,
+// it is no longer a member of the bag.
+// If n is not positive, Remove does nothing.
+func (b Bag[T]) Remove(val T, n int) {
+	if n <= 0 {
+		return
+	}
+	if b[val] <= n {
+		delete(b, val)
+		return
+	}
+	b[val] -= n
+}
+
+// Count tells how many copies of val are in the bag.
+// The bag may be nil.
+func (b Bag[T]) Count(val T) int {
+	return b[val]
+}
+
+// Has tells whether val has a positive count in the bag.
+// The bag may be nil.
+func (b Bag[T]) Has(val T) bool {
+	return b[val] > 0
+}
+
+// Len tells the number of distinct values in the bag.
+// The bag may be nil.
+func (b Bag[T]) Len() int {
+	return len(b)
+}
+
+// Total tells the sum of the counts of all values in the bag.
+// The bag may be nil.
+func (b Bag[T]) Total() int {
+	var n int
+	for _, count := range b {
+		n += count
+	}
+	return n
+}
+
+// Equal tests whether the bag has the same members with the same counts as another.
+// Either bag may be nil.
+func (b Bag[T]) Equal(other Bag[T]) bool {
+	return maps.Equal(b, other)
+}
+
+// Distinct produces a new set of the distinct values in the bag.
+// The bag may be nil.
+func (b Bag[T]) Distinct() Of[T] {
+	return Collect(maps.Keys(b))
+}
+
+// All produces an iterator over the members of the bag and their counts,
+// in an indeterminate order.
+// The bag may be nil.
+func (b Bag[T]) All() iter.Seq2[T, int] {
+	return maps.All(b)
+}
+
+// MostCommon produces the k values with the greatest counts,
+// in descending order of count.
+// If k is negative or greater than the number of distinct values,
+// all values are produced.
+// Values with equal counts appear in ascending order
+// if T is an integer, floating-point, or string type,
+// and in an indeterminate order otherwise.
+// The bag may be nil.
+func (b Bag[T]) MostCommon(k int) []T {
+	if k < 0 || k > len(b) {
+		k = len(b)
+	}
+	if k == 0 {
+		return nil
+	}
+
+	vals := slices.Collect(maps.Keys(b))
+	sortOrdered(vals)
+	slices.SortStableFunc(vals, func(x, y T) int {
+		return cmp.Compare(b[y], b[x])
+	})
+	return vals[:k]
+}
+
+// UnionBag produces a new bag containing every value in any of the given bags,
+// with the greatest of its counts.
+// The input may include nils,
+// representing empty bags.
+// The result is never nil (but may be empty).
+func UnionBag[T comparable](bags ...Bag[T]) Bag[T] {
+	result := make(Bag[T])
+	for _, b := range bags {
+		for val, count := range b {
+			result[val] = max(result[val], count)
+		}
+	}
+	return result
+}
+
+// SumBag produces a new bag containing every value in any of the given bags,
+// with the sum of its counts.
+// The input may include nils,
+// representing empty bags.
+// The result is never nil (but may be empty).
+func SumBag[T comparable](bags ...Bag[T]) Bag[T] {
+	result := make(Bag[T])
+	for _, b := range bags {
+		for val, count := range b {
+			result[val] += count
+		}
+	}
+	return result
+}
+
+// IntersectBag produces a new bag containing only values that appear in all the given bags,
+// with the least of their counts.
+// The input may include nils,
+// representing empty bags
+// and therefore producing an empty (but non-nil) intersection.
+func IntersectBag[T comparable](bags ...Bag[T]) Bag[T] {
+	result := make(Bag[T])
+	if len(bags) == 0 {
+		return result
+	}
+
+OUTER:
+	for val, count := range bags[0] {
+		for _, b := range bags[1:] {
+			other := b[val]
+			if other == 0 {
+				continue OUTER
+			}
+			count = min(count, other)
+		}
+		result[val] = count
+	}
+	return result
+}
+
+// DiffBag produces a new bag containing the values in b1
+// with their counts reduced by their counts in b2.
+// Values whose counts fall to zero or below are omitted.
+// Either bag may be nil.
+// The result is never nil (but may be empty).
+func DiffBag[T comparable](b1, b2 Bag[T]) Bag[T] {
+	result := make(Bag[T])
+	for val, count := range b1 {
+		if count > b2[val] {
+			result[val] = count - b2[val]
+		}
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBag(t *testing.T) {
+	b := NewBag("a", "b", "a", "c", "a", "b")
+	if b.Count("a") != 3 || b.Count("b") != 2 || b.Count("c") != 1 || b.Count("d") != 0 {
+		t.Errorf("got %v, want a:3 b:2 c:1", b)
+	}
+	if b.Len() != 3 {
+		t.Errorf("got len %d, want 3", b.Len())
+	}
+	if b.Total() != 6 {
+		t.Errorf("got total %d, want 6", b.Total())
+	}
+
+	b.Add("d", 2)
+	b.Add("d", 0)
+	b.Remove("a", 1)
+	b.Remove("c", 5)
+	if !b.Equal(Bag[string]{"a": 2, "b": 2, "d": 2}) {
+		t.Errorf("got %v, want a:2 b:2 d:2", b)
+	}
+	if b.Has("c") {
+		t.Error("bag should not contain c")
+	}
+	if !b.Distinct().Equal(New("a", "b", "d")) {
+		t.Errorf("got %v, want [a b d]", b.Distinct())
+	}
+
+	got := make(map[string]int)
+	for val, count := range b.All() {
+		got[val] = count
+	}
+	if !Bag[string](got).Equal(b) {
+		t.Errorf("got %v, want %v", got, b)
+	}
+}
+
+func TestBagMostCommon(t *testing.T) {
+	b := Bag[string]{"x": 1, "y": 5, "z": 3, "w": 3}
+	if got := b.MostCommon(3); !slices.Equal(got, []string{"y", "w", "z"}) {
+		t.Errorf("got %v, want [y w z]", got)
+	}
+	if got := b.MostCommon(-1); len(got) != 4 {
+		t.Errorf("got %v, want all 4 values", got)
+	}
+	var empty Bag[string]
+	if got := empty.MostCommon(2); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestBagAlgebra(t *testing.T) {
+	var (
+		b1 = Bag[string]{"a": 3, "b": 1, "c": 2}
+		b2 = Bag[string]{"a": 1, "b": 4, "d": 1}
+	)
+
+	cases := []struct {
+		name      string
+		got, want Bag[string]
+	}{
+		{"union", UnionBag(b1, b2, nil), Bag[string]{"a": 3, "b": 4, "c": 2, "d": 1}},
+		{"sum", SumBag(b1, b2, nil), Bag[string]{"a": 4, "b": 5, "c": 2, "d": 1}},
+		{"intersect", IntersectBag(b1, b2), Bag[string]{"a": 1, "b": 1}},
+		{"intersect_nil", IntersectBag(b1, nil), Bag[string]{}},
+		{"diff", DiffBag(b1, b2), Bag[string]{"a": 2, "c": 2}},
+		{"diff_nil", DiffBag(b1, nil), b1},
+	}
+	for _, tc := range cases {
+		if !tc.got.Equal(tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+}
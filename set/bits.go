@@ -0,0 +1,243 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+// Integer is a constraint for integer types,
+// such as the members of [Bits] and [Integers].
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Bits is a set of small non-negative integers,
+// represented densely as a bitmap.
+// It uses one bit of memory for every integer from zero up to its greatest member,
+// which makes it far more compact than [Of] for sets of small integer IDs and enum values,
+// and it performs set algebra a word at a time.
+//
+// The zero value of Bits is an empty set ready to use.
+// A nil *Bits may be used for read-only operations,
+// where it behaves as an empty set.
+type Bits[T Integer] struct {
+	words []uint64
+}
+
+// NewBits produces a new bitset containing the given values.
+// It panics if any value is negative.
+func NewBits[T Integer](vals ...T) *Bits[T] {
+	s := new(Bits[T])
+	s.Add(vals...)
+	return s
+}
+
+// CollectBits collects the members of the given sequence into a new bitset.
+// It panics if any value is negative.
+func CollectBits[T Integer](inp iter.Seq[T]) *Bits[T] {
+	s := new(Bits[T])
+	s.AddSeq(inp)
+	return s
+}
+
+// BitsOf produces a new bitset with the same members as the given set.
+// The input may be nil.
+// It panics if any member is negative.
+func BitsOf[T Integer](s Of[T]) *Bits[T] {
+	return CollectBits(s.All())
+}
+
+func (s *Bits[T]) slice() []uint64 {
+	if s == nil {
+		return nil
+	}
+	return s.words
+}
+
+// Add adds the given values to the set.
+// Items already present in the set are silently ignored.
+// It panics if any value is negative.
+func (s *Bits[T]) Add(vals ...T) {
+	for _, val := range vals {
+		if val < 0 {
+			panic(fmt.Sprintf("negative value %d added to set.Bits", val))
+		}
+		w, b := uint64(val)/64, uint64(val)%64
+		if w >= uint64(len(s.words)) {
+			s.words = append(s.words, make([]uint64, w+1-uint64(len(s.words)))...)
+		}
+		s.words[w] |= 1 << b
+	}
+}
+
+// AddSeq adds the members of the given sequence to the set.
+// It panics if any value is negative.
+func (s *Bits[T]) AddSeq(inp iter.Seq[T]) {
+	for val := range inp {
+		s.Add(val)
+	}
+}
+
+// Has tells whether the given value is in the set.
+// The set may be nil.
+func (s *Bits[T]) Has(val T) bool {
+	if val < 0 {
+		return false
+	}
+	words := s.slice()
+	w, b := uint64(val)/64, uint64(val)%64
+	return w < uint64(len(words)) && words[w]&(1<<b) != 0
+}
+
+// Del removes the given items from the set.
+// Items already absent from the set are silently ignored.
+func (s *Bits[T]) Del(vals ...T) {
+	for _, val := range vals {
+		if val < 0 {
+			continue
+		}
+		w, b := uint64(val)/64, uint64(val)%64
+		if w < uint64(len(s.words)) {
+			s.words[w] &^= 1 << b
+		}
+	}
+	s.trim()
+}
+
+// trim removes trailing zero words.
+func (s *Bits[T]) trim() {
+	n := len(s.words)
+	for n > 0 && s.words[n-1] == 0 {
+		n--
+	}
+	s.words = s.words[:n]
+}
+
+// Len tells the number of distinct values in the set.
+// The set may be nil.
+func (s *Bits[T]) Len() int {
+	var n int
+	for _, word := range s.slice() {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// Equal tests whether the set has the same membership as another.
+// Either set may be nil.
+func (s *Bits[T]) Equal(other *Bits[T]) bool {
+	a, b := s.slice(), other.slice()
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	for i, word := range b {
+		if i < len(a) {
+			if a[i] != word {
+				return false
+			}
+		} else if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// All produces an iterator over the members of the set,
+// in ascending order.
+// The set may be nil.
+// It is safe to add and remove items during iteration,
+// but that can affect the sequence of values seen later in the same iteration.
+func (s *Bits[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for w := 0; w < len(s.slice()); w++ {
+			word := s.words[w]
+			for word != 0 {
+				b := bits.TrailingZeros64(word)
+				if !yield(T(w*64 + b)) {
+					return
+				}
+				word &^= 1 << b
+			}
+		}
+	}
+}
+
+// Slice produces a new slice of the elements in the set,
+// in ascending order.
+func (s *Bits[T]) Slice() []T {
+	n := s.Len()
+	if n == 0 {
+		return nil
+	}
+	result := make([]T, 0, n)
+	for val := range s.All() {
+		result = append(result, val)
+	}
+	return result
+}
+
+// Set produces a new [Of] with the same members as s.
+// The set may be nil.
+func (s *Bits[T]) Set() Of[T] {
+	return Collect(s.All())
+}
+
+// IntersectBits produces a new bitset containing only items that appear in all the given sets.
+// The input may include nils,
+// representing empty sets
+// and therefore producing an empty (but non-nil) intersection.
+func IntersectBits[T Integer](sets ...*Bits[T]) *Bits[T] {
+	result := new(Bits[T])
+	if len(sets) == 0 {
+		return result
+	}
+	n := len(sets[0].slice())
+	for _, s := range sets[1:] {
+		n = min(n, len(s.slice()))
+	}
+	result.words = make([]uint64, n)
+	copy(result.words, sets[0].slice())
+	for _, s := range sets[1:] {
+		for i := range result.words {
+			result.words[i] &= s.words[i]
+		}
+	}
+	result.trim()
+	return result
+}
+
+// UnionBits produces a new bitset containing all the items in all the given sets.
+// The input may include nils,
+// representing empty sets.
+// The result is never nil (but may be empty).
+func UnionBits[T Integer](sets ...*Bits[T]) *Bits[T] {
+	var n int
+	for _, s := range sets {
+		n = max(n, len(s.slice()))
+	}
+	result := &Bits[T]{words: make([]uint64, n)}
+	for _, s := range sets {
+		for i, word := range s.slice() {
+			result.words[i] |= word
+		}
+	}
+	return result
+}
+
+// DiffBits produces a new bitset containing the items in s1 that are not also in s2.
+// Either set may be nil.
+// The result is never nil (but may be empty).
+func DiffBits[T Integer](s1, s2 *Bits[T]) *Bits[T] {
+	result := &Bits[T]{words: make([]uint64, len(s1.slice()))}
+	copy(result.words, s1.slice())
+	for i, word := range s2.slice() {
+		if i >= len(result.words) {
+			break
+		}
+		result.words[i] &^= word
+	}
+	result.trim()
+	return result
+}
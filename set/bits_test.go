@@ -0,0 +1,86 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBits(t *testing.T) {
+	s := NewBits(3, 64, 0, 200, 3)
+	if s.Len() != 4 {
+		t.Errorf("got len %d, want 4", s.Len())
+	}
+	for _, val := range []int{0, 3, 64, 200} {
+		if !s.Has(val) {
+			t.Errorf("set should contain %d", val)
+		}
+	}
+	for _, val := range []int{-1, 1, 63, 65, 199, 1000} {
+		if s.Has(val) {
+			t.Errorf("set should not contain %d", val)
+		}
+	}
+	if got := s.Slice(); !slices.Equal(got, []int{0, 3, 64, 200}) {
+		t.Errorf("got %v, want [0 3 64 200]", got)
+	}
+
+	s.Del(200, -5, 5000)
+	if got := slices.Collect(s.All()); !slices.Equal(got, []int{0, 3, 64}) {
+		t.Errorf("got %v, want [0 3 64]", got)
+	}
+	if !s.Equal(NewBits(64, 3, 0)) {
+		t.Error("sets should be equal")
+	}
+	if s.Equal(NewBits(0, 3)) {
+		t.Error("sets should not be equal")
+	}
+
+	var empty *Bits[uint8]
+	if empty.Len() != 0 || empty.Has(0) || !empty.Equal(new(Bits[uint8])) {
+		t.Error("nil set should be empty")
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("adding a negative value should panic")
+			}
+		}()
+		s.Add(-1)
+	}()
+}
+
+func TestBitsConversion(t *testing.T) {
+	of := New[uint16](7, 700, 70)
+	b := BitsOf(of)
+	if got := b.Slice(); !slices.Equal(got, []uint16{7, 70, 700}) {
+		t.Errorf("got %v, want [7 70 700]", got)
+	}
+	if !b.Set().Equal(of) {
+		t.Errorf("got %v, want %v", b.Set(), of)
+	}
+}
+
+func TestBitsAlgebra(t *testing.T) {
+	var (
+		s1 = NewBits(1, 2, 3, 100, 300)
+		s2 = NewBits(2, 3, 4, 100)
+		s3 = NewBits(3, 100, 1000)
+	)
+
+	if got := IntersectBits(s1, s2, s3).Slice(); !slices.Equal(got, []int{3, 100}) {
+		t.Errorf("got %v, want [3 100]", got)
+	}
+	if got := IntersectBits(s1, nil); got == nil || got.Len() != 0 {
+		t.Errorf("got %v, want empty", got)
+	}
+	if got := UnionBits(s1, s2, nil).Slice(); !slices.Equal(got, []int{1, 2, 3, 4, 100, 300}) {
+		t.Errorf("got %v, want [1 2 3 4 100 300]", got)
+	}
+	if got := DiffBits(s1, s2).Slice(); !slices.Equal(got, []int{1, 300}) {
+		t.Errorf("got %v, want [1 300]", got)
+	}
+	if got := DiffBits(s3, s1).Slice(); !slices.Equal(got, []int{1000}) {
+		t.Errorf("got %v, want [1000]", got)
+	}
+}
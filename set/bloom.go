@@ -0,0 +1,219 @@
+package set
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+// Bloom is a Bloom filter:
+// a compact, probabilistic representation of a set of elements of type T.
+// It can tell for certain that a value is not in the set,
+// but can only say that a value may be in the set,
+// with a false-positive rate chosen when the filter is created.
+//
+// Create a Bloom with NewBloom or NewBloomFunc.
+// The zero value is not safe for use.
+type Bloom[T any] struct {
+	words []uint64
+	m     uint64 // number of bits
+	k     int    // number of hash functions
+	hash  func(T) uint64
+}
+
+// ErrIncompatibleBloom is the error produced when combining Bloom filters
+// with different sizes or numbers of hash functions.
+var ErrIncompatibleBloom = errors.New("incompatible Bloom filters")
+
+// NewBloom produces a new, empty Bloom filter
+// sized for n elements
+// with a false-positive rate of p
+// (which must be between 0 and 1, exclusive).
+//
+// NewBloom supplies a hash function for T
+// if T's underlying type is a string, integer, floating-point, or boolean type,
+// and panics otherwise.
+// For other types, use [NewBloomFunc].
+func NewBloom[T any](n int, p float64) *Bloom[T] {
+	hash := defaultHash[T]()
+	if hash == nil {
+		panic(fmt.Sprintf("no default hash function for %s; use NewBloomFunc", reflect.TypeFor[T]()))
+	}
+	return NewBloomFunc(n, p, hash)
+}
+
+// NewBloomFunc produces a new, empty Bloom filter
+// sized for n elements
+// with a false-positive rate of p
+// (which must be between 0 and 1, exclusive),
+// using the given hash function.
+//
+// The hash function should distribute its results well over all 64 bits.
+// Filters that will be combined with [UnionBloom]
+// or serialized and deserialized
+// must use the same hash function.
+func NewBloomFunc[T any](n int, p float64, hash func(T) uint64) *Bloom[T] {
+	if p <= 0 || p >= 1 {
+		panic(fmt.Sprintf("false-positive rate %v out of range", p))
+	}
+	n = max(n, 1)
+
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	m = max(m, 64)
+	k := int(math.Round(float64(m) / float64(n) * math.Ln2))
+	k = max(k, 1)
+
+	return &Bloom[T]{
+		words: make([]uint64, (m+63)/64),
+		m:     m,
+		k:     k,
+		hash:  hash,
+	}
+}
+
+// locations calls f with each of the k bit positions for val.
+// It uses double hashing to derive k positions from a single 64-bit hash.
+// It stops early if f returns false.
+func (b *Bloom[T]) locations(val T, f func(uint64) bool) {
+	h1 := b.hash(val)
+	h2 := mix64(h1) | 1
+	for i := 0; i < b.k; i++ {
+		if !f((h1 + uint64(i)*h2) % b.m) {
+			return
+		}
+	}
+}
+
+// Add adds the given values to the filter.
+func (b *Bloom[T]) Add(vals ...T) {
+	for _, val := range vals {
+		b.locations(val, func(loc uint64) bool {
+			b.words[loc/64] |= 1 << (loc % 64)
+			return true
+		})
+	}
+}
+
+// MayHave tells whether val may be in the filter.
+// A false result is certain.
+// A true result is wrong with about the probability
+// given when the filter was created,
+// provided no more than the expected number of elements have been added.
+func (b *Bloom[T]) MayHave(val T) bool {
+	result := true
+	b.locations(val, func(loc uint64) bool {
+		if b.words[loc/64]&(1<<(loc%64)) == 0 {
+			result = false
+		}
+		return result
+	})
+	return result
+}
+
+// EstimateLen estimates the number of distinct values added to the filter.
+func (b *Bloom[T]) EstimateLen() int {
+	var x int
+	for _, word := range b.words {
+		x += bits.OnesCount64(word)
+	}
+	if uint64(x) >= b.m {
+		return math.MaxInt
+	}
+	m, k := float64(b.m), float64(b.k)
+	return int(math.Round(-m / k * math.Log(1-float64(x)/m)))
+}
+
+// UnionBloom produces a new Bloom filter
+// that may have any value that may be in any of the given filters.
+// The filters must all have been created with the same size, false-positive rate, and hash function,
+// or an error wrapping [ErrIncompatibleBloom] is returned.
+// There must be at least one filter.
+func UnionBloom[T any](filters ...*Bloom[T]) (*Bloom[T], error) {
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("%w: no filters", ErrIncompatibleBloom)
+	}
+	first := filters[0]
+	result := &Bloom[T]{
+		words: make([]uint64, len(first.words)),
+		m:     first.m,
+		k:     first.k,
+		hash:  first.hash,
+	}
+	for i, f := range filters {
+		if f.m != first.m || f.k != first.k {
+			return nil, fmt.Errorf("%w: filter %d has m=%d, k=%d; filter 0 has m=%d, k=%d", ErrIncompatibleBloom, i, f.m, f.k, first.m, first.k)
+		}
+		for j, word := range f.words {
+			result.words[j] |= word
+		}
+	}
+	return result, nil
+}
+
+const bloomVersion = 1
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// The hash function is not included in the encoding.
+func (b *Bloom[T]) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 1+binary.MaxVarintLen64*2+8*len(b.words))
+	buf = append(buf, bloomVersion)
+	buf = binary.AppendUvarint(buf, b.m)
+	buf = binary.AppendUvarint(buf, uint64(b.k))
+	for _, word := range b.words {
+		buf = binary.LittleEndian.AppendUint64(buf, word)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It replaces the contents of b with the decoded filter.
+// Since the hash function is not part of the encoding,
+// b keeps its existing hash function if it has one.
+// Otherwise (as when b is a zero Bloom)
+// it uses the same default hash function that [NewBloom] would,
+// returning an error if there is none.
+func (b *Bloom[T]) UnmarshalBinary(data []byte) error {
+	hash := b.hash
+	if hash == nil {
+		hash = defaultHash[T]()
+		if hash == nil {
+			return fmt.Errorf("no default hash function for %s", reflect.TypeFor[T]())
+		}
+	}
+
+	if len(data) == 0 || data[0] != bloomVersion {
+		return errors.New("unknown Bloom filter encoding")
+	}
+	data = data[1:]
+
+	m, n := binary.Uvarint(data)
+	if n <= 0 || m == 0 {
+		return errors.New("malformed Bloom filter size")
+	}
+	data = data[n:]
+
+	k, n := binary.Uvarint(data)
+	if n <= 0 || k == 0 || k > math.MaxInt32 {
+		return errors.New("malformed Bloom filter hash count")
+	}
+	data = data[n:]
+
+	// Computed this way to avoid overflow when m is near 2^64.
+	nwords := m / 64
+	if m%64 != 0 {
+		nwords++
+	}
+	if len(data)%8 != 0 || uint64(len(data)/8) != nwords {
+		return fmt.Errorf("got %d bytes of Bloom filter data, want %d words", len(data), nwords)
+	}
+	words := make([]uint64, nwords)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+
+	*b = Bloom[T]{words: words, m: m, k: int(k), hash: hash}
+	return nil
+}
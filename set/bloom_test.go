@@ -0,0 +1,128 @@
+package set
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestBloom(t *testing.T) {
+	const (
+		n = 10000
+		p = 0.01
+	)
+	b := NewBloom[int](n, p)
+	for i := 0; i < n; i++ {
+		b.Add(i)
+	}
+	for i := 0; i < n; i++ {
+		if !b.MayHave(i) {
+			t.Fatalf("false negative for %d", i)
+		}
+	}
+
+	var fp int
+	for i := n; i < 2*n; i++ {
+		if b.MayHave(i) {
+			fp++
+		}
+	}
+	if rate := float64(fp) / n; rate > 2*p {
+		t.Errorf("got false-positive rate %v, want about %v", rate, p)
+	}
+
+	if est := b.EstimateLen(); math.Abs(float64(est-n)) > n/20 {
+		t.Errorf("got estimated len %d, want about %d", est, n)
+	}
+}
+
+func TestBloomFunc(t *testing.T) {
+	type point struct{ X, Y int }
+	hash := func(p point) uint64 { return mix64(uint64(p.X)<<32 | uint64(uint32(p.Y))) }
+
+	b := NewBloomFunc(100, 0.001, hash)
+	b.Add(point{1, 2}, point{3, 4})
+	if !b.MayHave(point{1, 2}) || !b.MayHave(point{3, 4}) {
+		t.Error("false negative")
+	}
+	if b.MayHave(point{5, 6}) {
+		t.Error("unexpected false positive")
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("NewBloom for a struct type should panic")
+			}
+		}()
+		NewBloom[point](100, 0.01)
+	}()
+}
+
+func TestUnionBloom(t *testing.T) {
+	var (
+		b1 = NewBloom[string](100, 0.01)
+		b2 = NewBloom[string](100, 0.01)
+	)
+	b1.Add("a", "b")
+	b2.Add("c")
+
+	u, err := UnionBloom(b1, b2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, val := range []string{"a", "b", "c"} {
+		if !u.MayHave(val) {
+			t.Errorf("false negative for %s", val)
+		}
+	}
+	if b1.MayHave("c") {
+		t.Error("UnionBloom modified its input")
+	}
+
+	_, err = UnionBloom(b1, NewBloom[string](1000, 0.01))
+	if !errors.Is(err, ErrIncompatibleBloom) {
+		t.Errorf("got error %v, want %v", err, ErrIncompatibleBloom)
+	}
+}
+
+func TestBloomBinary(t *testing.T) {
+	b := NewBloom[string](500, 0.01)
+	for i := 0; i < 500; i++ {
+		b.Add(fmt.Sprintf("key%d", i))
+	}
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Bloom[string]
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 500; i++ {
+		if !got.MayHave(fmt.Sprintf("key%d", i)) {
+			t.Fatalf("false negative for key%d after round trip", i)
+		}
+	}
+	if got.EstimateLen() != b.EstimateLen() {
+		t.Errorf("got estimated len %d, want %d", got.EstimateLen(), b.EstimateLen())
+	}
+
+	if err := got.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Error("got no error for truncated data")
+	}
+	if err := got.UnmarshalBinary(nil); err == nil {
+		t.Error("got no error for empty data")
+	}
+
+	// A size near 2^64 must not overflow the word count.
+	huge := []byte{bloomVersion}
+	huge = binary.AppendUvarint(huge, math.MaxUint64)
+	huge = binary.AppendUvarint(huge, 3)
+	if err := got.UnmarshalBinary(huge); err == nil {
+		t.Error("got no error for an oversized filter with no data")
+	}
+}
@@ -0,0 +1,56 @@
+package set
+
+// Delta is a set of changes to a set:
+// members to add and members to remove.
+// Either field may be nil,
+// representing no changes of that kind.
+//
+// A Delta produced by [Changes] has disjoint Added and Removed sets,
+// and Removed contains only members of the original set.
+// The results of [Delta.Invert] and [Delta.Compose]
+// are exact only for such deltas.
+type Delta[T comparable] struct {
+	Added, Removed Of[T]
+}
+
+// Changes produces the Delta that turns the set before into the set after.
+// Either set may be nil.
+// The fields of the result are never nil (but may be empty).
+func Changes[T comparable](before, after Of[T]) Delta[T] {
+	return Delta[T]{
+		Added:   Diff(after, before),
+		Removed: Diff(before, after),
+	}
+}
+
+// Apply changes s in place,
+// removing the members of d.Removed
+// and adding the members of d.Added.
+// The set s may be nil only if d.Added is empty.
+func (d Delta[T]) Apply(s Of[T]) {
+	s.Subtract(d.Removed)
+	s.UnionWith(d.Added)
+}
+
+// Invert produces the Delta that undoes d.
+func (d Delta[T]) Invert() Delta[T] {
+	return Delta[T]{Added: d.Removed, Removed: d.Added}
+}
+
+// Compose produces a single Delta with the same effect as applying d and then other.
+// A member added by one and removed by the other
+// (or vice versa)
+// appears in neither field of the result,
+// since the two changes cancel out.
+// The fields of the result are never nil (but may be empty).
+func (d Delta[T]) Compose(other Delta[T]) Delta[T] {
+	return Delta[T]{
+		Added:   Union(Diff(d.Added, other.Removed), Diff(other.Added, d.Removed)),
+		Removed: Union(Diff(d.Removed, other.Added), Diff(other.Removed, d.Added)),
+	}
+}
+
+// IsEmpty tells whether d makes no changes.
+func (d Delta[T]) IsEmpty() bool {
+	return d.Added.Len() == 0 && d.Removed.Len() == 0
+}
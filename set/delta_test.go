@@ -0,0 +1,46 @@
+package set
+
+import "testing"
+
+func TestDelta(t *testing.T) {
+	var (
+		v1 = New(1, 2, 3)
+		v2 = New(2, 3, 4, 5)
+		v3 = New(1, 3, 5, 6)
+	)
+
+	d12 := Changes(v1, v2)
+	if !d12.Added.Equal(New(4, 5)) || !d12.Removed.Equal(New(1)) {
+		t.Errorf("got %+v, want added [4 5], removed [1]", d12)
+	}
+
+	s := Collect(v1.All())
+	d12.Apply(s)
+	if !s.Equal(v2) {
+		t.Errorf("after Apply got %v, want %v", s, v2)
+	}
+	d12.Invert().Apply(s)
+	if !s.Equal(v1) {
+		t.Errorf("after inverse Apply got %v, want %v", s, v1)
+	}
+
+	var (
+		d23 = Changes(v2, v3)
+		d13 = d12.Compose(d23)
+	)
+	if !d13.Added.Equal(New(5, 6)) || !d13.Removed.Equal(New(2)) {
+		t.Errorf("got %+v, want added [5 6], removed [2]", d13)
+	}
+	s = Collect(v1.All())
+	d13.Apply(s)
+	if !s.Equal(v3) {
+		t.Errorf("after composed Apply got %v, want %v", s, v3)
+	}
+
+	if !d12.Compose(d12.Invert()).IsEmpty() {
+		t.Error("a delta composed with its inverse should be empty")
+	}
+	if !Changes[int](nil, nil).IsEmpty() || !(Delta[int]{}).IsEmpty() {
+		t.Error("delta between empty sets should be empty")
+	}
+}
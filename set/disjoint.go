@@ -0,0 +1,131 @@
+package set
+
+// Disjoint is a disjoint-set (union-find) structure:
+// a partition of elements of type T into non-overlapping sets.
+// Each element starts in a set by itself,
+// and [Disjoint.Union] merges sets together.
+//
+// Operations take nearly constant amortized time,
+// thanks to path compression and union by rank.
+//
+// Values not yet added are treated as being in sets by themselves.
+//
+// The zero value of Disjoint is an empty partition ready to use.
+type Disjoint[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	nsets  int
+}
+
+// NewDisjoint produces a new partition in which each of the given values is in a set by itself.
+func NewDisjoint[T comparable](vals ...T) *Disjoint[T] {
+	d := new(Disjoint[T])
+	d.Add(vals...)
+	return d
+}
+
+// Add adds each of the given values to the partition in a set by itself.
+// Values already present are silently ignored.
+func (d *Disjoint[T]) Add(vals ...T) {
+	if d.parent == nil {
+		d.parent = make(map[T]T)
+		d.rank = make(map[T]int)
+	}
+	for _, val := range vals {
+		if _, ok := d.parent[val]; ok {
+			continue
+		}
+		d.parent[val] = val
+		d.nsets++
+	}
+}
+
+// Has tells whether the given value has been added to the partition.
+func (d *Disjoint[T]) Has(val T) bool {
+	_, ok := d.parent[val]
+	return ok
+}
+
+// Len tells the number of values in the partition.
+func (d *Disjoint[T]) Len() int {
+	return len(d.parent)
+}
+
+// NumSets tells the number of sets in the partition.
+func (d *Disjoint[T]) NumSets() int {
+	return d.nsets
+}
+
+// Find produces the representative member of the set containing x.
+// Two values are in the same set if and only if they have the same representative.
+// If x has not been added to the partition,
+// it is its own representative.
+func (d *Disjoint[T]) Find(x T) T {
+	root := x
+	for {
+		p, ok := d.parent[root]
+		if !ok || p == root {
+			break
+		}
+		root = p
+	}
+
+	// Path compression.
+	for x != root {
+		next := d.parent[x]
+		d.parent[x] = root
+		x = next
+	}
+
+	return root
+}
+
+// Union merges the sets containing a and b,
+// adding either value to the partition if necessary.
+// It tells whether a and b were previously in different sets.
+func (d *Disjoint[T]) Union(a, b T) bool {
+	d.Add(a, b)
+
+	ra, rb := d.Find(a), d.Find(b)
+	if ra == rb {
+		return false
+	}
+
+	// Union by rank.
+	switch rankA, rankB := d.rank[ra], d.rank[rb]; {
+	case rankA < rankB:
+		d.parent[ra] = rb
+	case rankA > rankB:
+		d.parent[rb] = ra
+	default:
+		d.parent[rb] = ra
+		d.rank[ra]++
+	}
+	d.nsets--
+	return true
+}
+
+// Same tells whether a and b are in the same set.
+func (d *Disjoint[T]) Same(a, b T) bool {
+	return d.Find(a) == d.Find(b)
+}
+
+// Groups produces the sets of the partition,
+// in an indeterminate order.
+func (d *Disjoint[T]) Groups() []Of[T] {
+	var (
+		byRoot = make(map[T]Of[T], d.nsets)
+		result = make([]Of[T], 0, d.nsets)
+	)
+	for val := range d.parent {
+		root := d.Find(val)
+		group, ok := byRoot[root]
+		if !ok {
+			group = New[T]()
+			byRoot[root] = group
+			result = append(result, group)
+		}
+		group.Add(val)
+	}
+	return result
+}
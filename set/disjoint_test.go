@@ -0,0 +1,57 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDisjoint(t *testing.T) {
+	d := NewDisjoint(1, 2, 3, 4, 5, 6)
+	if d.NumSets() != 6 || d.Len() != 6 {
+		t.Errorf("got %d sets of %d values, want 6 of 6", d.NumSets(), d.Len())
+	}
+
+	if !d.Union(1, 2) {
+		t.Error("Union(1, 2) reported no change")
+	}
+	d.Union(3, 4)
+	d.Union(2, 4)
+	if d.Union(1, 3) {
+		t.Error("Union(1, 3) reported a change")
+	}
+	d.Union(7, 8) // adds new values
+
+	if !d.Same(1, 4) || !d.Same(7, 8) {
+		t.Error("values should be in the same set")
+	}
+	if d.Same(1, 5) || d.Same(5, 6) || d.Same(4, 7) {
+		t.Error("values should be in different sets")
+	}
+	if !d.Same(9, 9) || d.Same(9, 10) || d.Has(9) {
+		t.Error("values not yet added should be in sets by themselves")
+	}
+	if d.NumSets() != 4 || d.Len() != 8 {
+		t.Errorf("got %d sets of %d values, want 4 of 8", d.NumSets(), d.Len())
+	}
+
+	groups := d.Groups()
+	if len(groups) != 4 {
+		t.Fatalf("got %d groups, want 4", len(groups))
+	}
+	var sizes []int
+	for _, g := range groups {
+		sizes = append(sizes, g.Len())
+		if g.Has(1) && !g.Equal(New(1, 2, 3, 4)) {
+			t.Errorf("got group %v, want [1 2 3 4]", g)
+		}
+	}
+	slices.Sort(sizes)
+	if !slices.Equal(sizes, []int{1, 1, 2, 4}) {
+		t.Errorf("got group sizes %v, want [1 1 2 4]", sizes)
+	}
+
+	var zero Disjoint[string]
+	if zero.NumSets() != 0 || zero.Find("x") != "x" || len(zero.Groups()) != 0 {
+		t.Error("zero value should be an empty partition")
+	}
+}
@@ -0,0 +1,224 @@
+package set
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// MarshalJSON implements [json.Marshaler].
+// The set is encoded as a JSON array of its members.
+// If T is an integer, floating-point, or string type,
+// the array is sorted,
+// so the output is deterministic.
+// The set may be nil,
+// in which case it is encoded as an empty array.
+func (s Of[T]) MarshalJSON() ([]byte, error) {
+	vals := s.sortedIfOrdered()
+	if vals == nil {
+		vals = []T{}
+	}
+	return json.Marshal(vals)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// It decodes a JSON array,
+// adding its elements to any existing members of the set.
+// Duplicate elements are silently ignored.
+// A JSON null leaves the set unchanged.
+func (s *Of[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var vals []T
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+	s.addAll(vals)
+	return nil
+}
+
+// Strings is a set of strings (or values of some other string type T)
+// that implements [encoding.TextMarshaler] and [encoding.TextUnmarshaler],
+// and also [database/sql/driver.Valuer] and [database/sql.Scanner]
+// (see [Strings.Value]).
+// It has the same underlying type as [Of],
+// so it is cheap to convert between the two:
+// use Strings[T](s) to get text or SQL encoding for an Of[T],
+// and Of[T](t) to get the methods of Of back.
+//
+// (Of itself does not implement those interfaces,
+// since many encoders and database drivers prefer them when present,
+// and most sets cannot be represented as text.)
+type Strings[T ~string] Of[T]
+
+// MarshalText implements [encoding.TextMarshaler].
+// It encodes the set as a single line of comma-separated values
+// (quoted where necessary, as in CSV),
+// in sorted order.
+func (s Strings[T]) MarshalText() ([]byte, error) {
+	vals := SortedSlice(Of[T](s))
+	if len(vals) == 1 && vals[0] == "" {
+		// A lone empty field would be encoded as a blank line,
+		// indistinguishable from the empty set.
+		return []byte(`""`), nil
+	}
+
+	strs := make([]string, 0, len(vals))
+	for _, val := range vals {
+		strs = append(strs, string(val))
+	}
+
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	if err := w.Write(strs); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+// It decodes the format produced by [Strings.MarshalText],
+// adding the values to any existing members of the set.
+// Duplicate values are silently ignored.
+func (s *Strings[T]) UnmarshalText(text []byte) error {
+	if *s == nil {
+		*s = Strings[T](New[T]())
+	}
+	if len(text) == 0 {
+		return nil
+	}
+
+	r := csv.NewReader(bytes.NewReader(text))
+	r.FieldsPerRecord = -1
+	strs, err := r.Read()
+	if err != nil {
+		return fmt.Errorf("decoding set text: %w", err)
+	}
+	for _, str := range strs {
+		(*s)[T(str)] = struct{}{}
+	}
+	return nil
+}
+
+// GobEncode implements [gob.GobEncoder].
+// The set may be nil.
+func (s Of[T]) GobEncode() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(s.sortedIfOrdered()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements [gob.GobDecoder].
+// It adds the decoded values to any existing members of the set.
+func (s *Of[T]) GobDecode(data []byte) error {
+	var vals []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&vals); err != nil {
+		return err
+	}
+	s.addAll(vals)
+	return nil
+}
+
+// addAll adds vals to *s,
+// first allocating the set if it is nil.
+func (s *Of[T]) addAll(vals []T) {
+	if *s == nil {
+		*s = New[T]()
+	}
+	s.Add(vals...)
+}
+
+// sortedIfOrdered produces the members of s as a slice,
+// sorted if T is an ordered type (see [sortOrdered]).
+func (s Of[T]) sortedIfOrdered() []T {
+	vals := s.Slice()
+	sortOrdered(vals)
+	return vals
+}
+
+// isOrdered tells whether T's underlying type
+// is an integer, floating-point, or string type.
+func isOrdered[T any]() bool {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	}
+	return false
+}
+
+// sortOrdered sorts vals in ascending order
+// if T's underlying type is an integer, floating-point, or string type,
+// and tells whether it did.
+// Otherwise it leaves vals unchanged.
+func sortOrdered[T any](vals []T) bool {
+	// Sort the common unnamed types directly.
+	switch vals := any(vals).(type) {
+	case []int:
+		slices.Sort(vals)
+		return true
+	case []int64:
+		slices.Sort(vals)
+		return true
+	case []uint64:
+		slices.Sort(vals)
+		return true
+	case []float64:
+		slices.Sort(vals)
+		return true
+	case []string:
+		slices.Sort(vals)
+		return true
+	}
+
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sortByKey(vals, reflect.Value.Int)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		sortByKey(vals, reflect.Value.Uint)
+	case reflect.Float32, reflect.Float64:
+		sortByKey(vals, reflect.Value.Float)
+	case reflect.String:
+		sortByKey(vals, reflect.Value.String)
+	default:
+		return false
+	}
+	return true
+}
+
+// sortByKey sorts vals in ascending order of their keys.
+// Each key is extracted with reflection just once,
+// not on every comparison.
+func sortByKey[T any, K cmp.Ordered](vals []T, key func(reflect.Value) K) {
+	type keyed struct {
+		k K
+		v T
+	}
+	var (
+		rv  = reflect.ValueOf(vals)
+		kvs = make([]keyed, len(vals))
+	)
+	for i, v := range vals {
+		kvs[i] = keyed{k: key(rv.Index(i)), v: v}
+	}
+	slices.SortFunc(kvs, func(a, b keyed) int {
+		return cmp.Compare(a.k, b.k)
+	})
+	for i, kv := range kvs {
+		vals[i] = kv.v
+	}
+}
@@ -0,0 +1,153 @@
+package set
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	s := New(10, 3, 200, -1)
+	got, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "[-1,3,10,200]" {
+		t.Errorf("got %s, want [-1,3,10,200]", got)
+	}
+
+	var nilSet Of[int]
+	got, err = json.Marshal(nilSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "[]" {
+		t.Errorf("got %s, want []", got)
+	}
+
+	var decoded Of[int]
+	if err := json.Unmarshal([]byte("[5, 1, 5, 2]"), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(New(1, 2, 5)) {
+		t.Errorf("got %v, want [1 2 5]", decoded)
+	}
+
+	type point struct{ X, Y int }
+	var points Of[point]
+	if err := json.Unmarshal([]byte(`[{"X":1,"Y":2},{"X":3,"Y":4}]`), &points); err != nil {
+		t.Fatal(err)
+	}
+	if !points.Equal(New(point{1, 2}, point{3, 4})) {
+		t.Errorf("got %v, want [{1 2} {3 4}]", points)
+	}
+
+	var wrapper struct{ S Of[string] }
+	if err := json.Unmarshal([]byte(`{"S": null}`), &wrapper); err != nil {
+		t.Fatal(err)
+	}
+	if wrapper.S != nil {
+		t.Errorf("got %v, want nil", wrapper.S)
+	}
+}
+
+func TestText(t *testing.T) {
+	cases := []struct {
+		name string
+		s    Strings[string]
+		want string
+	}{{
+		name: "empty",
+		s:    Strings[string](New[string]()),
+		want: "",
+	}, {
+		name: "simple",
+		s:    Strings[string](New("c", "a", "b")),
+		want: "a,b,c",
+	}, {
+		name: "quoted",
+		s:    Strings[string](New("x,y", `say "hi"`, "z")),
+		want: `"say ""hi""","x,y",z`,
+	}, {
+		name: "lone_empty_string",
+		s:    Strings[string](New("")),
+		want: `""`,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.s.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+
+			var decoded Strings[string]
+			if err := decoded.UnmarshalText(got); err != nil {
+				t.Fatal(err)
+			}
+			if !Of[string](decoded).Equal(Of[string](tc.s)) {
+				t.Errorf("got %v, want %v", decoded, tc.s)
+			}
+		})
+	}
+
+	if _, ok := any(New(1)).(encoding.TextMarshaler); ok {
+		t.Error("Of should not implement encoding.TextMarshaler")
+	}
+}
+
+func TestGob(t *testing.T) {
+	type wrapper struct {
+		S Of[string]
+	}
+	w := wrapper{S: New("a", "b", "c")}
+
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(w); err != nil {
+		t.Fatal(err)
+	}
+
+	var got wrapper
+	if err := gob.NewDecoder(buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.S.Equal(w.S) {
+		t.Errorf("got %v, want %v", got.S, w.S)
+	}
+}
+
+func TestSortOrdered(t *testing.T) {
+	type (
+		celsius float32
+		port    uint16
+		name    string
+	)
+
+	temps := []celsius{3.5, -1, 2}
+	if !sortOrdered(temps) || !slices.Equal(temps, []celsius{-1, 2, 3.5}) {
+		t.Errorf("got %v, want [-1 2 3.5]", temps)
+	}
+	ports := []port{443, 80, 8080}
+	if !sortOrdered(ports) || !slices.Equal(ports, []port{80, 443, 8080}) {
+		t.Errorf("got %v, want [80 443 8080]", ports)
+	}
+	names := []name{"b", "c", "a"}
+	if !sortOrdered(names) || !slices.Equal(names, []name{"a", "b", "c"}) {
+		t.Errorf("got %v, want [a b c]", names)
+	}
+	ints := []int{3, -2, 1}
+	if !sortOrdered(ints) || !slices.Equal(ints, []int{-2, 1, 3}) {
+		t.Errorf("got %v, want [-2 1 3]", ints)
+	}
+
+	pairs := [][2]int{{2, 1}, {1, 2}}
+	if sortOrdered(pairs) || pairs[0] != [2]int{2, 1} {
+		t.Errorf("got %v, want unsorted input", pairs)
+	}
+}
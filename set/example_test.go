@@ -67,3 +67,10 @@ func ExampleOf() {
 	// 100 is in the set? false
 	// set size is 5
 }
+
+func ExampleOf_String() {
+	s := set.New("banana", "cherry", "apple")
+	fmt.Println(s)
+	// Output:
+	// {apple, banana, cherry}
+}
@@ -0,0 +1,149 @@
+package set
+
+import (
+	"hash/maphash"
+	"iter"
+	"reflect"
+	"runtime"
+	"sync"
+	"weak"
+)
+
+// Frozen is an immutable set of elements of type T.
+// Unlike [Of],
+// Frozen is comparable:
+// two Frozen sets are == if and only if they have the same members.
+// So frozen sets can be members of other sets,
+// and keys in maps.
+//
+// This works by interning:
+// all live Frozen sets with the same members
+// share a single underlying representation.
+// Creating a Frozen set takes O(n) expected time.
+//
+// The zero value of Frozen is the empty set.
+type Frozen[T comparable] struct {
+	d *frozenData[T]
+}
+
+type frozenData[T comparable] struct {
+	s    Of[T]
+	hash uint64
+}
+
+// frozenTable is the intern table for Frozen sets of one element type.
+// It holds its entries weakly,
+// so a Frozen set's representation can be reclaimed
+// once no Frozen value refers to it.
+type frozenTable[T comparable] struct {
+	mu      sync.Mutex
+	buckets map[uint64][]weak.Pointer[frozenData[T]]
+}
+
+var (
+	frozenSeed   = maphash.MakeSeed()
+	frozenTables sync.Map // reflect.Type -> *frozenTable[T]
+)
+
+// Freeze produces a frozen set with the same members as s.
+// The input may be nil.
+// Later changes to s do not affect the result.
+func Freeze[T comparable](s Of[T]) Frozen[T] {
+	if len(s) == 0 {
+		return Frozen[T]{}
+	}
+
+	// The hash of a set is the sum of the mixed hashes of its members,
+	// which does not depend on the order in which they are visited.
+	var h uint64
+	for val := range s {
+		h += mix64(maphash.Comparable(frozenSeed, val))
+	}
+
+	table := getFrozenTable[T]()
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	for _, wp := range table.buckets[h] {
+		if d := wp.Value(); d != nil && d.s.Equal(s) {
+			return Frozen[T]{d: d}
+		}
+	}
+
+	d := &frozenData[T]{s: Collect(s.All()), hash: h}
+	table.buckets[h] = append(table.buckets[h], weak.Make(d))
+	runtime.AddCleanup(d, table.prune, h)
+	return Frozen[T]{d: d}
+}
+
+// NewFrozen produces a frozen set containing the given values.
+func NewFrozen[T comparable](vals ...T) Frozen[T] {
+	return Freeze(New(vals...))
+}
+
+func getFrozenTable[T comparable]() *frozenTable[T] {
+	typ := reflect.TypeFor[T]()
+	if table, ok := frozenTables.Load(typ); ok {
+		return table.(*frozenTable[T])
+	}
+	table, _ := frozenTables.LoadOrStore(typ, &frozenTable[T]{buckets: make(map[uint64][]weak.Pointer[frozenData[T]])})
+	return table.(*frozenTable[T])
+}
+
+// prune removes reclaimed entries from the given bucket.
+func (t *frozenTable[T]) prune(h uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var live []weak.Pointer[frozenData[T]]
+	for _, wp := range t.buckets[h] {
+		if wp.Value() != nil {
+			live = append(live, wp)
+		}
+	}
+	if len(live) == 0 {
+		delete(t.buckets, h)
+	} else {
+		t.buckets[h] = live
+	}
+}
+
+func (f Frozen[T]) set() Of[T] {
+	if f.d == nil {
+		return nil
+	}
+	return f.d.s
+}
+
+// Has tells whether the given value is in the set.
+func (f Frozen[T]) Has(val T) bool {
+	return f.set().Has(val)
+}
+
+// Len tells the number of distinct values in the set.
+func (f Frozen[T]) Len() int {
+	return f.set().Len()
+}
+
+// All produces an iterator over the members of the set,
+// in an indeterminate order.
+func (f Frozen[T]) All() iter.Seq[T] {
+	return f.set().All()
+}
+
+// Slice produces a new slice of the elements in the set.
+// The slice is in an indeterminate order.
+func (f Frozen[T]) Slice() []T {
+	return f.set().Slice()
+}
+
+// Thaw produces a new, mutable [Of] with the same members as f.
+func (f Frozen[T]) Thaw() Of[T] {
+	return Collect(f.All())
+}
+
+// String implements [fmt.Stringer].
+// It formats the set in the same way as [Of.String].
+func (f Frozen[T]) String() string {
+	return f.set().String()
+}
@@ -0,0 +1,104 @@
+package set
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFrozen(t *testing.T) {
+	var (
+		a = Freeze(New(1, 2, 3))
+		b = NewFrozen(3, 2, 1, 2)
+		c = NewFrozen(1, 2)
+	)
+	if a != b {
+		t.Error("frozen sets with the same members should be ==")
+	}
+	if a == c {
+		t.Error("frozen sets with different members should be !=")
+	}
+	if NewFrozen[int]() != (Frozen[int]{}) || Freeze[int](nil) != (Frozen[int]{}) {
+		t.Error("empty frozen sets should equal the zero value")
+	}
+
+	if !a.Has(2) || a.Has(4) || a.Len() != 3 {
+		t.Error("wrong membership")
+	}
+	if !Collect(a.All()).Equal(New(1, 2, 3)) || !New(a.Slice()...).Equal(New(1, 2, 3)) {
+		t.Errorf("got %v, want [1 2 3]", a)
+	}
+	if got := a.String(); got != "{1, 2, 3}" {
+		t.Errorf("got %s, want {1, 2, 3}", got)
+	}
+
+	thawed := a.Thaw()
+	thawed.Add(4)
+	if a.Has(4) {
+		t.Error("changing a thawed set should not affect the frozen set")
+	}
+
+	orig := New(5, 6)
+	frozen := Freeze(orig)
+	orig.Add(7)
+	if frozen.Has(7) {
+		t.Error("changing the original set should not affect the frozen set")
+	}
+}
+
+func TestFrozenAsKey(t *testing.T) {
+	memo := make(map[Frozen[string]]int)
+	memo[NewFrozen("x", "y")] = 1
+	memo[NewFrozen("y", "x")]++
+	memo[NewFrozen("z")] = 10
+	if len(memo) != 2 || memo[NewFrozen("x", "y")] != 2 {
+		t.Errorf("got %v, want 2 entries with {x, y} => 2", memo)
+	}
+
+	sets := New(NewFrozen(1), NewFrozen(1, 2), NewFrozen(2, 1))
+	if sets.Len() != 2 {
+		t.Errorf("got %d sets of sets, want 2", sets.Len())
+	}
+}
+
+func TestFrozenConcurrent(t *testing.T) {
+	var (
+		wg      sync.WaitGroup
+		results = make([]Frozen[int], 16)
+	)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = NewFrozen(10, 20, 30)
+		}()
+	}
+	wg.Wait()
+	for i, f := range results {
+		if f != results[0] {
+			t.Errorf("result %d differs", i)
+		}
+	}
+}
+
+func TestFrozenReclaim(t *testing.T) {
+	type elem struct{ n int }
+	for i := 0; i < 100; i++ {
+		NewFrozen(elem{i})
+	}
+	// Cleanups run asynchronously after collection,
+	// so poll for a while.
+	table := getFrozenTable[elem]()
+	for i := 0; i < 100; i++ {
+		runtime.GC()
+		table.mu.Lock()
+		n := len(table.buckets)
+		table.mu.Unlock()
+		if n == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Error("frozen sets were not reclaimed")
+}
@@ -0,0 +1,72 @@
+package set
+
+import (
+	"math"
+	"reflect"
+)
+
+// defaultHash produces a hash function for T
+// if T's underlying type is a string, integer, floating-point, or boolean type.
+// Otherwise it returns nil.
+//
+// Unlike [hash/maphash],
+// the resulting hashes are the same in every process,
+// so structures built from them
+// (such as [Bloom] and [HLL])
+// can be serialized and shared.
+func defaultHash[T any]() func(T) uint64 {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.String:
+		return func(val T) uint64 { return fnv1a(reflect.ValueOf(val).String()) }
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(val T) uint64 { return mix64(uint64(reflect.ValueOf(val).Int())) }
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(val T) uint64 { return mix64(reflect.ValueOf(val).Uint()) }
+
+	case reflect.Float32, reflect.Float64:
+		return func(val T) uint64 {
+			f := reflect.ValueOf(val).Float()
+			if f == 0 {
+				f = 0 // normalize -0 to +0, since they compare equal
+			}
+			return mix64(math.Float64bits(f))
+		}
+
+	case reflect.Bool:
+		return func(val T) uint64 {
+			if reflect.ValueOf(val).Bool() {
+				return mix64(1)
+			}
+			return mix64(0)
+		}
+	}
+	return nil
+}
+
+// fnv1a is the 64-bit FNV-1a hash of s,
+// passed through [mix64] to spread its bits.
+func fnv1a(s string) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+	h := uint64(offset)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= prime
+	}
+	return mix64(h)
+}
+
+// mix64 is the finalizer from the SplitMix64 generator.
+// It turns x into a well-distributed 64-bit hash.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
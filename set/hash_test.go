@@ -0,0 +1,26 @@
+package set
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDefaultHash(t *testing.T) {
+	type myString string
+
+	if h := defaultHash[myString](); h("abc") != fnv1a("abc") {
+		t.Error("string hash should depend only on the string value")
+	}
+	if h := defaultHash[int8](); h(-1) != defaultHash[int64]()(-1) {
+		t.Error("integer hash should depend only on the integer value")
+	}
+	if h := defaultHash[float64](); h(0) != h(math.Copysign(0, -1)) {
+		t.Error("+0 and -0 should hash the same")
+	}
+	if h := defaultHash[bool](); h(true) == h(false) {
+		t.Error("true and false should hash differently")
+	}
+	if h := defaultHash[struct{}](); h != nil {
+		t.Error("got a default hash for a struct type")
+	}
+}
@@ -0,0 +1,185 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+// HLL is a HyperLogLog sketch:
+// an estimator of the number of distinct values of type T added to it,
+// using a small, fixed amount of memory
+// no matter how many values there are.
+//
+// The precision p chosen when the sketch is created
+// determines its size (2^p bytes)
+// and its typical relative error (about 1.04/sqrt(2^p)).
+//
+// Create an HLL with NewHLL or NewHLLFunc.
+// The zero value is not safe for use.
+type HLL[T any] struct {
+	p    uint8
+	regs []uint8
+	hash func(T) uint64
+}
+
+// ErrIncompatibleHLL is the error produced when merging HyperLogLog sketches
+// with different precisions.
+var ErrIncompatibleHLL = errors.New("incompatible HyperLogLog sketches")
+
+// Limits on the precision of an [HLL].
+const (
+	MinHLLPrecision = 4
+	MaxHLLPrecision = 18
+)
+
+// NewHLL produces a new, empty HyperLogLog sketch with the given precision,
+// which must be between [MinHLLPrecision] and [MaxHLLPrecision].
+//
+// NewHLL supplies a hash function for T
+// if T's underlying type is a string, integer, floating-point, or boolean type,
+// and panics otherwise.
+// For other types, use [NewHLLFunc].
+func NewHLL[T any](precision int) *HLL[T] {
+	hash := defaultHash[T]()
+	if hash == nil {
+		panic(fmt.Sprintf("no default hash function for %s; use NewHLLFunc", reflect.TypeFor[T]()))
+	}
+	return NewHLLFunc(precision, hash)
+}
+
+// NewHLLFunc produces a new, empty HyperLogLog sketch with the given precision,
+// which must be between [MinHLLPrecision] and [MaxHLLPrecision],
+// using the given hash function.
+//
+// The hash function should distribute its results well over all 64 bits.
+// Sketches that will be merged
+// or serialized and deserialized
+// must use the same hash function.
+func NewHLLFunc[T any](precision int, hash func(T) uint64) *HLL[T] {
+	if precision < MinHLLPrecision || precision > MaxHLLPrecision {
+		panic(fmt.Sprintf("HyperLogLog precision %d out of range", precision))
+	}
+	return &HLL[T]{
+		p:    uint8(precision),
+		regs: make([]uint8, 1<<precision),
+		hash: hash,
+	}
+}
+
+// Add adds the given values to the sketch.
+func (h *HLL[T]) Add(vals ...T) {
+	for _, val := range vals {
+		x := h.hash(val)
+		idx := x >> (64 - h.p)
+
+		// The rank is the position of the leftmost 1 bit in the remaining bits,
+		// capped by the sentinel bit at position 64-p.
+		rank := uint8(bits.LeadingZeros64(x<<h.p|1<<(h.p-1))) + 1
+		h.regs[idx] = max(h.regs[idx], rank)
+	}
+}
+
+// Estimate estimates the number of distinct values added to the sketch.
+func (h *HLL[T]) Estimate() uint64 {
+	var (
+		m     = float64(len(h.regs))
+		sum   float64
+		zeros int
+	)
+	for _, r := range h.regs {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	var alpha float64
+	switch len(h.regs) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+
+	est := alpha * m * m / sum
+	if est <= 2.5*m && zeros > 0 {
+		// Small-range correction (linear counting).
+		est = m * math.Log(m/float64(zeros))
+	}
+	return uint64(math.Round(est))
+}
+
+// Merge adds to h all the values that have been added to the given sketches,
+// so that h estimates the number of distinct values in their union.
+// The sketches must all have the same precision as h
+// and should use the same hash function,
+// or an error wrapping [ErrIncompatibleHLL] is returned
+// and h is unchanged.
+func (h *HLL[T]) Merge(others ...*HLL[T]) error {
+	for i, other := range others {
+		if other.p != h.p {
+			return fmt.Errorf("%w: sketch %d has precision %d, want %d", ErrIncompatibleHLL, i, other.p, h.p)
+		}
+	}
+	for _, other := range others {
+		for i, r := range other.regs {
+			h.regs[i] = max(h.regs[i], r)
+		}
+	}
+	return nil
+}
+
+const hllVersion = 1
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// The hash function is not included in the encoding.
+func (h *HLL[T]) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 2+len(h.regs))
+	buf = append(buf, hllVersion, h.p)
+	buf = append(buf, h.regs...)
+	return buf, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It replaces the contents of h with the decoded sketch.
+// Since the hash function is not part of the encoding,
+// h keeps its existing hash function if it has one.
+// Otherwise (as when h is a zero HLL)
+// it uses the same default hash function that [NewHLL] would,
+// returning an error if there is none.
+func (h *HLL[T]) UnmarshalBinary(data []byte) error {
+	hash := h.hash
+	if hash == nil {
+		hash = defaultHash[T]()
+		if hash == nil {
+			return fmt.Errorf("no default hash function for %s", reflect.TypeFor[T]())
+		}
+	}
+
+	if len(data) < 2 || data[0] != hllVersion {
+		return errors.New("unknown HyperLogLog encoding")
+	}
+	p := data[1]
+	if p < MinHLLPrecision || p > MaxHLLPrecision {
+		return fmt.Errorf("HyperLogLog precision %d out of range", p)
+	}
+	data = data[2:]
+	if len(data) != 1<<p {
+		return fmt.Errorf("got %d HyperLogLog registers, want %d", len(data), 1<<p)
+	}
+	for _, r := range data {
+		if r > 64-p+1 {
+			return fmt.Errorf("HyperLogLog register value %d out of range", r)
+		}
+	}
+
+	*h = HLL[T]{p: p, regs: append([]uint8(nil), data...), hash: hash}
+	return nil
+}
@@ -0,0 +1,98 @@
+package set
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestHLL(t *testing.T) {
+	for _, n := range []int{0, 10, 1000, 100000} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			h := NewHLL[int](14)
+			for i := 0; i < n; i++ {
+				h.Add(i, i) // duplicates do not count
+			}
+			est := float64(h.Estimate())
+			if math.Abs(est-float64(n)) > 0.03*float64(n)+1 {
+				t.Errorf("got estimate %v, want about %d", est, n)
+			}
+		})
+	}
+}
+
+func TestHLLMerge(t *testing.T) {
+	var (
+		h1 = NewHLL[string](12)
+		h2 = NewHLL[string](12)
+	)
+	for i := 0; i < 20000; i++ {
+		h1.Add(fmt.Sprintf("user%d", i))
+	}
+	for i := 10000; i < 30000; i++ {
+		h2.Add(fmt.Sprintf("user%d", i))
+	}
+	if err := h1.Merge(h2); err != nil {
+		t.Fatal(err)
+	}
+	if est := float64(h1.Estimate()); math.Abs(est-30000) > 0.05*30000 {
+		t.Errorf("got estimate %v, want about 30000", est)
+	}
+
+	err := h1.Merge(NewHLL[string](10))
+	if !errors.Is(err, ErrIncompatibleHLL) {
+		t.Errorf("got error %v, want %v", err, ErrIncompatibleHLL)
+	}
+}
+
+func TestHLLFunc(t *testing.T) {
+	type key struct{ A, B int }
+	h := NewHLLFunc(10, func(k key) uint64 { return mix64(uint64(k.A)<<32 ^ uint64(k.B)) })
+	for i := 0; i < 500; i++ {
+		h.Add(key{i, i % 7})
+	}
+	if est := float64(h.Estimate()); math.Abs(est-500) > 0.1*500 {
+		t.Errorf("got estimate %v, want about 500", est)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("NewHLL for a struct type should panic")
+			}
+		}()
+		NewHLL[key](10)
+	}()
+}
+
+func TestHLLBinary(t *testing.T) {
+	h := NewHLL[uint64](8)
+	for i := uint64(0); i < 1000; i++ {
+		h.Add(i)
+	}
+	data, err := h.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got HLL[uint64]
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got.Estimate() != h.Estimate() {
+		t.Errorf("got estimate %d, want %d", got.Estimate(), h.Estimate())
+	}
+	got.Add(1, 2, 3) // already present
+	if data2, _ := got.MarshalBinary(); !bytes.Equal(data2, data) {
+		t.Error("decoded sketch does not use the same hash function")
+	}
+
+	if err := got.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Error("got no error for truncated data")
+	}
+	if err := got.UnmarshalBinary([]byte{hllVersion, 30}); err == nil {
+		t.Error("got no error for bad precision")
+	}
+}
@@ -0,0 +1,261 @@
+package set
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Keyed is a set of elements of type T
+// whose identity is determined by a key function.
+// Two elements with the same key are the same member of the set.
+// This makes it possible to have sets of non-comparable elements
+// (such as structs with slice fields),
+// and sets that use a normalized form of their elements for identity
+// (such as case-insensitive strings).
+//
+// The key function must be deterministic.
+// Sets combined with [IntersectKeyed], [UnionKeyed], and [DiffKeyed]
+// should use equivalent key functions.
+//
+// The zero value of Keyed is not safe for use.
+// Create one with NewKeyed instead.
+// A nil *Keyed may be used for read-only operations,
+// where it behaves as an empty set.
+type Keyed[T any, K comparable] struct {
+	key func(T) K
+	m   map[K]T
+}
+
+// NewKeyed produces a new keyed set using the given key function
+// and containing the given values.
+func NewKeyed[T any, K comparable](key func(T) K, vals ...T) *Keyed[T, K] {
+	s := &Keyed[T, K]{key: key, m: make(map[K]T)}
+	s.Add(vals...)
+	return s
+}
+
+// CollectKeyed collects the members of the given sequence into a new keyed set
+// using the given key function.
+func CollectKeyed[T any, K comparable](key func(T) K, inp iter.Seq[T]) *Keyed[T, K] {
+	s := NewKeyed(key)
+	s.AddSeq(inp)
+	return s
+}
+
+func (s *Keyed[T, K]) elems() map[K]T {
+	if s == nil {
+		return nil
+	}
+	return s.m
+}
+
+// Add adds the given values to the set.
+// Items whose keys are already present in the set are silently ignored;
+// the element already stored under that key is kept.
+func (s *Keyed[T, K]) Add(vals ...T) {
+	for _, val := range vals {
+		k := s.key(val)
+		if _, ok := s.m[k]; !ok {
+			s.m[k] = val
+		}
+	}
+}
+
+// AddSeq adds the members of the given sequence to the set.
+func (s *Keyed[T, K]) AddSeq(inp iter.Seq[T]) {
+	for val := range inp {
+		s.Add(val)
+	}
+}
+
+// Has tells whether an element with the same key as the given value is in the set.
+// The set may be nil.
+func (s *Keyed[T, K]) Has(val T) bool {
+	if s == nil {
+		return false
+	}
+	return s.HasKey(s.key(val))
+}
+
+// HasKey tells whether an element with the given key is in the set.
+// The set may be nil.
+func (s *Keyed[T, K]) HasKey(k K) bool {
+	_, ok := s.elems()[k]
+	return ok
+}
+
+// Get produces the element stored in the set under the given key.
+// The boolean result is false if there is no such element.
+// The set may be nil.
+func (s *Keyed[T, K]) Get(k K) (T, bool) {
+	val, ok := s.elems()[k]
+	return val, ok
+}
+
+// Del removes the elements with the same keys as the given items from the set.
+// Items already absent from the set are silently ignored.
+func (s *Keyed[T, K]) Del(vals ...T) {
+	for _, val := range vals {
+		delete(s.m, s.key(val))
+	}
+}
+
+// DelKey removes the elements with the given keys from the set.
+// Keys already absent from the set are silently ignored.
+func (s *Keyed[T, K]) DelKey(keys ...K) {
+	for _, k := range keys {
+		delete(s.m, k)
+	}
+}
+
+// Len tells the number of distinct keys in the set.
+// The set may be nil.
+func (s *Keyed[T, K]) Len() int {
+	return len(s.elems())
+}
+
+// Equal tests whether the set has the same keys as another.
+// Either set may be nil.
+func (s *Keyed[T, K]) Equal(other *Keyed[T, K]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+	for k := range s.elems() {
+		if !other.HasKey(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Each calls a function on each element of the set in an indeterminate order.
+// It is safe to add and remove items during a call to Each,
+// but that can affect the sequence of values seen later during the same Each call.
+// The set may be nil.
+func (s *Keyed[T, K]) Each(f func(T)) {
+	_ = s.Eachx(func(val T) error {
+		f(val)
+		return nil
+	})
+}
+
+// Eachx calls a function on each element of the set in an indeterminate order.
+// It is safe to add and remove items during a call to Eachx,
+// but that can affect the sequence of values seen later during the same Eachx call.
+// The set may be nil.
+// If the function returns an error,
+// Eachx stops and returns that error.
+func (s *Keyed[T, K]) Eachx(f func(T) error) error {
+	for _, val := range s.elems() {
+		if err := f(val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// All produces an iterator over the elements of the set,
+// in an indeterminate order.
+// The set may be nil.
+func (s *Keyed[T, K]) All() iter.Seq[T] {
+	return maps.Values(s.elems())
+}
+
+// Keys produces an iterator over the keys of the set,
+// in an indeterminate order.
+// The set may be nil.
+func (s *Keyed[T, K]) Keys() iter.Seq[K] {
+	return maps.Keys(s.elems())
+}
+
+// KeySet produces a new [Of] containing the keys of the set.
+// The set may be nil.
+func (s *Keyed[T, K]) KeySet() Of[K] {
+	return Collect(s.Keys())
+}
+
+// Slice produces a new slice of the elements in the set.
+// The slice is in an indeterminate order.
+func (s *Keyed[T, K]) Slice() []T {
+	if s.Len() == 0 {
+		return nil
+	}
+	return slices.Collect(s.All())
+}
+
+// keyedResult produces a new, empty keyed set
+// using the key function of the first non-nil set in sets.
+// If there is none,
+// the result is nil.
+func keyedResult[T any, K comparable](sets []*Keyed[T, K]) *Keyed[T, K] {
+	for _, s := range sets {
+		if s != nil {
+			return NewKeyed(s.key)
+		}
+	}
+	return nil
+}
+
+// IntersectKeyed produces a new keyed set containing only elements whose keys appear in all the given sets.
+// Elements are taken from the first set.
+// The input may include nils,
+// representing empty sets.
+// The result uses the key function of the first non-nil input set.
+// It is nil only if there is no such set.
+func IntersectKeyed[T any, K comparable](sets ...*Keyed[T, K]) *Keyed[T, K] {
+	result := keyedResult(sets)
+	if result == nil {
+		return nil
+	}
+	for _, s := range sets {
+		if s == nil {
+			return result
+		}
+	}
+
+OUTER:
+	for k, val := range sets[0].m {
+		for _, s := range sets[1:] {
+			if !s.HasKey(k) {
+				continue OUTER
+			}
+		}
+		result.m[k] = val
+	}
+	return result
+}
+
+// UnionKeyed produces a new keyed set containing all the elements in all the given sets.
+// When more than one set has an element with the same key,
+// the one from the earliest such set is kept.
+// The input may include nils,
+// representing empty sets.
+// The result uses the key function of the first non-nil input set.
+// It is nil only if there is no such set.
+func UnionKeyed[T any, K comparable](sets ...*Keyed[T, K]) *Keyed[T, K] {
+	result := keyedResult(sets)
+	for _, s := range sets {
+		for k, val := range s.elems() {
+			if _, ok := result.m[k]; !ok {
+				result.m[k] = val
+			}
+		}
+	}
+	return result
+}
+
+// DiffKeyed produces a new keyed set containing the elements in s1
+// whose keys are not also in s2.
+// Either set may be nil.
+// The result uses the key function of s1 (or of s2, if s1 is nil).
+// It is nil only if both inputs are.
+func DiffKeyed[T any, K comparable](s1, s2 *Keyed[T, K]) *Keyed[T, K] {
+	result := keyedResult([]*Keyed[T, K]{s1, s2})
+	for k, val := range s1.elems() {
+		if !s2.HasKey(k) {
+			result.m[k] = val
+		}
+	}
+	return result
+}
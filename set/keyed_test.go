@@ -0,0 +1,86 @@
+package set
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+type keyedPerson struct {
+	Name    string
+	Aliases []string
+}
+
+func TestKeyed(t *testing.T) {
+	s := NewKeyed(strings.ToLower, "Apple", "banana", "APPLE", "Cherry")
+	if s.Len() != 3 {
+		t.Errorf("got len %d, want 3", s.Len())
+	}
+	if !s.Has("aPpLe") {
+		t.Error("set should contain apple")
+	}
+	if got, ok := s.Get("apple"); !ok || got != "Apple" {
+		t.Errorf("got %q (%v), want Apple", got, ok)
+	}
+	if _, ok := s.Get("durian"); ok {
+		t.Error("set should not contain durian")
+	}
+
+	s.Del("BANANA")
+	s.DelKey("cherry", "durian")
+	if got := s.Slice(); !slices.Equal(got, []string{"Apple"}) {
+		t.Errorf("got %v, want [Apple]", got)
+	}
+	if !s.KeySet().Equal(New("apple")) {
+		t.Errorf("got keys %v, want [apple]", s.KeySet())
+	}
+
+	people := NewKeyed(
+		func(p keyedPerson) string { return p.Name },
+		keyedPerson{Name: "alice", Aliases: []string{"al"}},
+		keyedPerson{Name: "bob"},
+	)
+	if !people.Has(keyedPerson{Name: "alice"}) {
+		t.Error("set should contain alice")
+	}
+	if got, _ := people.Get("alice"); !slices.Equal(got.Aliases, []string{"al"}) {
+		t.Errorf("got aliases %v, want [al]", got.Aliases)
+	}
+
+	var empty *Keyed[string, string]
+	if empty.Len() != 0 || empty.Has("x") || empty.HasKey("x") || empty.Slice() != nil {
+		t.Error("nil set should be empty")
+	}
+}
+
+func TestKeyedAlgebra(t *testing.T) {
+	var (
+		s1 = NewKeyed(strings.ToLower, "A", "b", "C")
+		s2 = NewKeyed(strings.ToLower, "a", "B", "d")
+	)
+
+	i := IntersectKeyed(s1, s2)
+	if got := slices.Sorted(i.All()); !slices.Equal(got, []string{"A", "b"}) {
+		t.Errorf("got %v, want [A b]", got)
+	}
+	if i := IntersectKeyed(s1, nil); i == nil || i.Len() != 0 {
+		t.Errorf("got %v, want empty", i)
+	}
+
+	u := UnionKeyed(nil, s1, s2)
+	if got := slices.Sorted(u.All()); !slices.Equal(got, []string{"A", "C", "b", "d"}) {
+		t.Errorf("got %v, want [A C b d]", got)
+	}
+	u.Add("E")
+	if !u.Has("e") {
+		t.Error("union should use the input key function")
+	}
+
+	d := DiffKeyed(s1, s2)
+	if got := d.Slice(); !slices.Equal(got, []string{"C"}) {
+		t.Errorf("got %v, want [C]", got)
+	}
+	if d := DiffKeyed(nil, s2); d == nil || d.Len() != 0 {
+		t.Errorf("got %v, want empty", d)
+	}
+}
@@ -0,0 +1,125 @@
+package set
+
+import (
+	"iter"
+	"maps"
+)
+
+// Multi is a multimap:
+// a map from keys of type K to sets of values of type V.
+// Keys whose sets become empty are removed automatically,
+// so every key in a Multi has at least one value.
+//
+// The zero value of Multi is not safe for use.
+// Create one with NewMulti instead.
+type Multi[K, V comparable] map[K]Of[V]
+
+// NewMulti produces a new, empty multimap.
+func NewMulti[K, V comparable]() Multi[K, V] {
+	return make(Multi[K, V])
+}
+
+// Put adds the given values to the set for key k,
+// creating the set if necessary.
+// Values already present are silently ignored.
+func (m Multi[K, V]) Put(k K, vals ...V) {
+	if len(vals) == 0 {
+		return
+	}
+	s, ok := m[k]
+	if !ok {
+		s = New[V]()
+		m[k] = s
+	}
+	s.Add(vals...)
+}
+
+// Delete removes the given values from the set for key k.
+// If the set becomes empty,
+// k is removed from the multimap.
+// Values already absent are silently ignored.
+// The multimap may be nil.
+func (m Multi[K, V]) Delete(k K, vals ...V) {
+	s, ok := m[k]
+	if !ok {
+		return
+	}
+	s.Del(vals...)
+	if s.Len() == 0 {
+		delete(m, k)
+	}
+}
+
+// DeleteKey removes the given keys and all their values from the multimap.
+// The multimap may be nil.
+func (m Multi[K, V]) DeleteKey(keys ...K) {
+	for _, k := range keys {
+		delete(m, k)
+	}
+}
+
+// Get produces the set of values for key k,
+// or nil if there are none.
+// The caller should not modify the set;
+// use [Multi.Put] and [Multi.Delete] instead.
+// The multimap may be nil.
+func (m Multi[K, V]) Get(k K) Of[V] {
+	return m[k]
+}
+
+// Has tells whether the set for key k contains v.
+// The multimap may be nil.
+func (m Multi[K, V]) Has(k K, v V) bool {
+	return m[k].Has(v)
+}
+
+// HasKey tells whether k has any values in the multimap.
+// The multimap may be nil.
+func (m Multi[K, V]) HasKey(k K) bool {
+	_, ok := m[k]
+	return ok
+}
+
+// Len tells the number of key-value pairs in the multimap.
+// The multimap may be nil.
+func (m Multi[K, V]) Len() int {
+	var n int
+	for _, s := range m {
+		n += s.Len()
+	}
+	return n
+}
+
+// Keys produces an iterator over the keys of the multimap,
+// in an indeterminate order.
+// The multimap may be nil.
+func (m Multi[K, V]) Keys() iter.Seq[K] {
+	return maps.Keys(m)
+}
+
+// All produces an iterator over the key-value pairs of the multimap,
+// in an indeterminate order.
+// The multimap may be nil.
+func (m Multi[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, s := range m {
+			for v := range s {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Invert produces a new multimap from values to keys:
+// for each key k and value v in m,
+// the result maps v to k.
+// The multimap may be nil.
+func (m Multi[K, V]) Invert() Multi[V, K] {
+	result := NewMulti[V, K]()
+	for k, v := range m.All() {
+		result.Put(v, k)
+	}
+	return result
+}
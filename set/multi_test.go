@@ -0,0 +1,59 @@
+package set
+
+import "testing"
+
+func TestMulti(t *testing.T) {
+	m := NewMulti[string, int]()
+	m.Put("a", 1, 2)
+	m.Put("a", 2, 3)
+	m.Put("b", 1)
+	m.Put("c")
+
+	if !m.Get("a").Equal(New(1, 2, 3)) {
+		t.Errorf("got %v, want [1 2 3]", m.Get("a"))
+	}
+	if m.Get("c") != nil || m.HasKey("c") {
+		t.Error("putting no values should not create a key")
+	}
+	if !m.Has("b", 1) || m.Has("b", 2) || m.Has("z", 1) {
+		t.Error("wrong membership")
+	}
+	if m.Len() != 4 {
+		t.Errorf("got len %d, want 4", m.Len())
+	}
+	if !Collect(m.Keys()).Equal(New("a", "b")) {
+		t.Errorf("got keys %v, want [a b]", Collect(m.Keys()))
+	}
+
+	pairs := make(map[string]int)
+	for k, v := range m.All() {
+		pairs[k] += v
+	}
+	if pairs["a"] != 6 || pairs["b"] != 1 {
+		t.Errorf("got sums %v, want a:6 b:1", pairs)
+	}
+
+	inv := m.Invert()
+	if !inv.Get(1).Equal(New("a", "b")) || !inv.Get(3).Equal(New("a")) {
+		t.Errorf("got inverse %v", inv)
+	}
+
+	m.Delete("b", 1)
+	if m.HasKey("b") {
+		t.Error("deleting the last value should remove the key")
+	}
+	m.Delete("a", 1, 100)
+	m.Delete("z", 1)
+	if !m.Get("a").Equal(New(2, 3)) {
+		t.Errorf("got %v, want [2 3]", m.Get("a"))
+	}
+	m.DeleteKey("a")
+	if len(m) != 0 {
+		t.Errorf("got %v, want empty", m)
+	}
+
+	var empty Multi[string, int]
+	if empty.Len() != 0 || empty.Has("a", 1) || empty.Invert().Len() != 0 {
+		t.Error("nil multimap should be empty")
+	}
+}
@@ -0,0 +1,188 @@
+package set
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Observable is a set of elements of type T
+// that notifies subscribers when its membership changes.
+//
+// Each call to a mutating method
+// ([Observable.Add], [Observable.AddSeq], [Observable.Del], [Observable.DelSeq])
+// that changes the set
+// produces a single notification,
+// in the form of a [Delta] describing the change.
+// Values that were already present (when adding)
+// or already absent (when removing)
+// are not reported,
+// and a call that changes nothing produces no notification.
+//
+// Subscribers are called synchronously,
+// in the order they subscribed,
+// after the set has been changed.
+// Each subscriber receives its own copy of the Delta,
+// which it may keep or modify.
+// A subscriber may read the set,
+// and may unsubscribe itself or others,
+// but should not change the set.
+//
+// Like [Of], Observable is not safe for concurrent use by multiple goroutines.
+//
+// The zero value of Observable is an empty set ready to use.
+// A nil *Observable may be used for read-only operations,
+// where it behaves as an empty set.
+type Observable[T comparable] struct {
+	s         Of[T]
+	observers []*observer[T]
+}
+
+type observer[T comparable] struct {
+	f       func(Delta[T])
+	removed bool
+}
+
+// NewObservable produces a new observable set containing the given values.
+func NewObservable[T comparable](vals ...T) *Observable[T] {
+	return &Observable[T]{s: New(vals...)}
+}
+
+// Observe produces an observable set wrapping s,
+// which may be nil.
+// After this call,
+// s should be changed only through the observable set;
+// changes made to s directly are not reported.
+func Observe[T comparable](s Of[T]) *Observable[T] {
+	return &Observable[T]{s: s}
+}
+
+// Subscribe registers f to be called with each change to the set.
+// The result is a function that unsubscribes f.
+// It is safe to call it more than once.
+func (o *Observable[T]) Subscribe(f func(Delta[T])) (unsubscribe func()) {
+	obs := &observer[T]{f: f}
+	o.observers = append(o.observers, obs)
+	return func() {
+		if obs.removed {
+			return
+		}
+		obs.removed = true
+		// Replace the slice rather than modifying it,
+		// in case a notification is iterating over it.
+		o.observers = slices.DeleteFunc(slices.Clone(o.observers), func(other *observer[T]) bool { return other == obs })
+	}
+}
+
+// notify calls each subscriber with d.
+// Each subscriber gets its own copy of d's sets,
+// so one that modifies them does not affect the others.
+func (o *Observable[T]) notify(d Delta[T]) {
+	if d.IsEmpty() {
+		return
+	}
+	for _, obs := range o.observers {
+		if !obs.removed {
+			obs.f(Delta[T]{Added: maps.Clone(d.Added), Removed: maps.Clone(d.Removed)})
+		}
+	}
+}
+
+// Add adds the given values to the set,
+// notifying subscribers of any that were not already present.
+func (o *Observable[T]) Add(vals ...T) {
+	if len(o.observers) == 0 {
+		if o.s == nil {
+			o.s = New[T]()
+		}
+		o.s.Add(vals...)
+		return
+	}
+	o.AddSeq(slices.Values(vals))
+}
+
+// AddSeq adds the members of the given sequence to the set,
+// notifying subscribers once,
+// after the sequence is consumed,
+// of any that were not already present.
+func (o *Observable[T]) AddSeq(inp iter.Seq[T]) {
+	if o.s == nil {
+		o.s = New[T]()
+	}
+	if len(o.observers) == 0 {
+		o.s.AddSeq(inp)
+		return
+	}
+	added := New[T]()
+	for val := range inp {
+		if !o.s.Has(val) {
+			o.s.Add(val)
+			added.Add(val)
+		}
+	}
+	o.notify(Delta[T]{Added: added})
+}
+
+// Del removes the given values from the set,
+// notifying subscribers of any that were present.
+func (o *Observable[T]) Del(vals ...T) {
+	if len(o.observers) == 0 {
+		o.s.Del(vals...)
+		return
+	}
+	o.DelSeq(slices.Values(vals))
+}
+
+// DelSeq removes the members of the given sequence from the set,
+// notifying subscribers once,
+// after the sequence is consumed,
+// of any that were present.
+func (o *Observable[T]) DelSeq(inp iter.Seq[T]) {
+	if len(o.observers) == 0 {
+		for val := range inp {
+			o.s.Del(val)
+		}
+		return
+	}
+	removed := New[T]()
+	for val := range inp {
+		if o.s.Has(val) {
+			o.s.Del(val)
+			removed.Add(val)
+		}
+	}
+	o.notify(Delta[T]{Removed: removed})
+}
+
+func (o *Observable[T]) set() Of[T] {
+	if o == nil {
+		return nil
+	}
+	return o.s
+}
+
+// Has tells whether the given value is in the set.
+// The set may be nil.
+func (o *Observable[T]) Has(val T) bool {
+	return o.set().Has(val)
+}
+
+// Len tells the number of distinct values in the set.
+// The set may be nil.
+func (o *Observable[T]) Len() int {
+	return o.set().Len()
+}
+
+// All produces an iterator over the members of the set,
+// in an indeterminate order.
+// The set may be nil.
+func (o *Observable[T]) All() iter.Seq[T] {
+	return o.set().All()
+}
+
+// Slice produces a new slice of the elements in the set.
+// The slice is in an indeterminate order.
+// The set may be nil.
+func (o *Observable[T]) Slice() []T {
+	return o.set().Slice()
+}
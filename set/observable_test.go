@@ -0,0 +1,98 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestObservable(t *testing.T) {
+	var (
+		o      = NewObservable(1, 2)
+		deltas []Delta[int]
+	)
+	unsubscribe := o.Subscribe(func(d Delta[int]) { deltas = append(deltas, d) })
+
+	o.Add(2, 3, 4)
+	o.Add(1)      // no change, no notification
+	o.Del(4, 100) // only 4 is reported
+	o.AddSeq(slices.Values([]int{5, 6, 5}))
+
+	if len(deltas) != 3 {
+		t.Fatalf("got %d notifications, want 3", len(deltas))
+	}
+	checks := []struct {
+		added, removed Of[int]
+	}{
+		{New(3, 4), New[int]()},
+		{New[int](), New(4)},
+		{New(5, 6), New[int]()},
+	}
+	for i, c := range checks {
+		d := deltas[i]
+		if !d.Added.Equal(c.added) || !d.Removed.Equal(c.removed) {
+			t.Errorf("notification %d: got %+v, want added %v, removed %v", i, d, c.added, c.removed)
+		}
+	}
+	if !Collect(o.All()).Equal(New(1, 2, 3, 5, 6)) || o.Len() != 5 || !o.Has(6) {
+		t.Errorf("got %v, want [1 2 3 5 6]", o.Slice())
+	}
+
+	unsubscribe()
+	unsubscribe()
+	o.Add(7)
+	if len(deltas) != 3 {
+		t.Errorf("got %d notifications after unsubscribing, want 3", len(deltas))
+	}
+}
+
+func TestObservableUnsubscribeDuringNotify(t *testing.T) {
+	var (
+		o          Observable[string]
+		calls      []string
+		unsubFirst func()
+	)
+	unsubFirst = o.Subscribe(func(Delta[string]) {
+		calls = append(calls, "first")
+		unsubFirst()
+	})
+	o.Subscribe(func(Delta[string]) { calls = append(calls, "second") })
+
+	o.Add("a")
+	o.Add("b")
+	if !slices.Equal(calls, []string{"first", "second", "second"}) {
+		t.Errorf("got %v, want [first second second]", calls)
+	}
+
+	wrapped := New("x")
+	obs := Observe(wrapped)
+	obs.Del("x")
+	if wrapped.Has("x") {
+		t.Error("Observe should wrap the given set")
+	}
+}
+
+func TestObservableDeltaCopies(t *testing.T) {
+	o := NewObservable[int]()
+	if allocs := testing.AllocsPerRun(100, func() { o.Add(1); o.Del(1) }); allocs != 0 {
+		t.Errorf("got %v allocations with no subscribers, want 0", allocs)
+	}
+
+	var got []Delta[int]
+	o.Subscribe(func(d Delta[int]) {
+		d.Added.Add(99)
+		got = append(got, d)
+	})
+	o.Subscribe(func(d Delta[int]) {
+		got = append(got, d)
+	})
+	o.Add(1, 2)
+	if len(got) != 2 {
+		t.Fatalf("got %d notifications, want 2", len(got))
+	}
+	if !got[1].Added.Equal(New(1, 2)) {
+		t.Errorf("second subscriber got %v, want [1 2]", got[1].Added)
+	}
+	if o.Has(99) {
+		t.Error("a subscriber's change to its Delta affected the set")
+	}
+}
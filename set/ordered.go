@@ -0,0 +1,249 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Ordered is a set of elements of type T
+// that remembers the order in which its members were first added.
+// Iteration produces members in that order.
+//
+// Membership tests, additions, and removals take O(1) time
+// (amortized, in the case of removals).
+// Positional lookups with [Ordered.Index] and [Ordered.At]
+// take O(1) time if no members have been removed,
+// but otherwise may take O(n) time,
+// since they must skip over the removed members.
+// Positional lookups do not modify the set,
+// so like the other read-only methods
+// they may be called concurrently with each other.
+//
+// The zero value of Ordered is an empty set ready to use.
+// A nil *Ordered may be used for read-only operations,
+// where it behaves as an empty set.
+type Ordered[T comparable] struct {
+	idx     map[T]int // position of each member in entries
+	entries []orderedEntry[T]
+	dead    int // number of deleted entries
+}
+
+type orderedEntry[T any] struct {
+	val  T
+	dead bool
+}
+
+// NewOrdered produces a new insertion-ordered set containing the given values.
+func NewOrdered[T comparable](vals ...T) *Ordered[T] {
+	s := new(Ordered[T])
+	s.Add(vals...)
+	return s
+}
+
+// CollectOrdered collects the members of the given sequence into a new insertion-ordered set.
+func CollectOrdered[T comparable](inp iter.Seq[T]) *Ordered[T] {
+	s := new(Ordered[T])
+	s.AddSeq(inp)
+	return s
+}
+
+// Add adds the given values to the end of the set.
+// Items already present in the set are silently ignored,
+// and keep their original position.
+func (s *Ordered[T]) Add(vals ...T) {
+	if s.idx == nil {
+		s.idx = make(map[T]int)
+	}
+	for _, val := range vals {
+		if _, ok := s.idx[val]; ok {
+			continue
+		}
+		s.idx[val] = len(s.entries)
+		s.entries = append(s.entries, orderedEntry[T]{val: val})
+	}
+}
+
+// AddSeq adds the members of the given sequence to the end of the set.
+func (s *Ordered[T]) AddSeq(inp iter.Seq[T]) {
+	for val := range inp {
+		s.Add(val)
+	}
+}
+
+// Has tells whether the given value is in the set.
+// The set may be nil.
+func (s *Ordered[T]) Has(val T) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.idx[val]
+	return ok
+}
+
+// Del removes the given items from the set.
+// Items already absent from the set are silently ignored.
+// A removed item that is later added again goes to the end of the set.
+func (s *Ordered[T]) Del(vals ...T) {
+	for _, val := range vals {
+		i, ok := s.idx[val]
+		if !ok {
+			continue
+		}
+		delete(s.idx, val)
+		s.entries[i] = orderedEntry[T]{dead: true}
+		s.dead++
+	}
+	if s.dead > len(s.entries)/2 {
+		s.compact()
+	}
+}
+
+// compact removes deleted entries and renumbers the remaining ones.
+func (s *Ordered[T]) compact() {
+	if s.dead == 0 {
+		return
+	}
+	live := s.entries[:0]
+	for _, e := range s.entries {
+		if e.dead {
+			continue
+		}
+		s.idx[e.val] = len(live)
+		live = append(live, e)
+	}
+	clear(s.entries[len(live):])
+	s.entries = live
+	s.dead = 0
+}
+
+// Len tells the number of distinct values in the set.
+// The set may be nil.
+func (s *Ordered[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.idx)
+}
+
+// Equal tests whether the set has the same membership as another,
+// regardless of order.
+// Either set may be nil.
+func (s *Ordered[T]) Equal(other *Ordered[T]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+	for val := range s.All() {
+		if !other.Has(val) {
+			return false
+		}
+	}
+	return true
+}
+
+// Index tells the position of val in the set,
+// counting from zero,
+// or -1 if val is not in the set.
+// The set may be nil.
+func (s *Ordered[T]) Index(val T) int {
+	if !s.Has(val) {
+		return -1
+	}
+	i := s.idx[val]
+	if s.dead == 0 {
+		return i
+	}
+
+	// Subtract the removed entries that precede val.
+	result := i
+	for _, e := range s.entries[:i] {
+		if e.dead {
+			result--
+		}
+	}
+	return result
+}
+
+// At produces the member at position i in the set,
+// counting from zero.
+// It panics if i is out of range.
+func (s *Ordered[T]) At(i int) T {
+	if i < 0 || i >= s.Len() {
+		panic(fmt.Sprintf("index %d out of range for set of length %d", i, s.Len()))
+	}
+	if s.dead == 0 {
+		return s.entries[i].val
+	}
+	for _, e := range s.entries {
+		if e.dead {
+			continue
+		}
+		if i == 0 {
+			return e.val
+		}
+		i--
+	}
+	panic("unreachable")
+}
+
+// Each calls a function on each element of the set in insertion order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (s *Ordered[T]) Each(f func(T)) {
+	_ = s.Eachx(func(val T) error {
+		f(val)
+		return nil
+	})
+}
+
+// Eachx calls a function on each element of the set in insertion order.
+// The set may be nil.
+// It should not be modified during iteration.
+// If the function returns an error,
+// Eachx stops and returns that error.
+func (s *Ordered[T]) Eachx(f func(T) error) error {
+	for val := range s.All() {
+		if err := f(val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// All produces an iterator over the members of the set,
+// in insertion order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (s *Ordered[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s == nil {
+			return
+		}
+		for _, e := range s.entries {
+			if e.dead {
+				continue
+			}
+			if !yield(e.val) {
+				return
+			}
+		}
+	}
+}
+
+// Slice produces a new slice of the elements in the set,
+// in insertion order.
+func (s *Ordered[T]) Slice() []T {
+	if s.Len() == 0 {
+		return nil
+	}
+	result := make([]T, 0, s.Len())
+	for val := range s.All() {
+		result = append(result, val)
+	}
+	return result
+}
+
+// Set produces a new [Of] with the same members as s.
+// The set may be nil.
+func (s *Ordered[T]) Set() Of[T] {
+	return Collect(s.All())
+}
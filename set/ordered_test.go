@@ -0,0 +1,124 @@
+package set
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestOrdered(t *testing.T) {
+	s := NewOrdered("c", "a", "b", "a", "c")
+	if got := s.Slice(); !slices.Equal(got, []string{"c", "a", "b"}) {
+		t.Errorf("got %v, want [c a b]", got)
+	}
+	if s.Len() != 3 {
+		t.Errorf("got len %d, want 3", s.Len())
+	}
+	if !s.Has("a") || s.Has("z") {
+		t.Error("wrong membership")
+	}
+
+	s.Add("d", "e", "f")
+	s.Del("a", "z")
+	if got := slices.Collect(s.All()); !slices.Equal(got, []string{"c", "b", "d", "e", "f"}) {
+		t.Errorf("got %v, want [c b d e f]", got)
+	}
+	if got := s.Index("d"); got != 2 {
+		t.Errorf("got index %d, want 2", got)
+	}
+	if got := s.Index("a"); got != -1 {
+		t.Errorf("got index %d, want -1", got)
+	}
+	if got := s.At(3); got != "e" {
+		t.Errorf("got %q at 3, want e", got)
+	}
+
+	s.Add("a")
+	if got := s.Index("a"); got != 5 {
+		t.Errorf("got index %d, want 5", got)
+	}
+
+	s.Del("c", "b", "d", "e")
+	if got := s.Slice(); !slices.Equal(got, []string{"f", "a"}) {
+		t.Errorf("got %v, want [f a]", got)
+	}
+	if got := s.At(0); got != "f" {
+		t.Errorf("got %q at 0, want f", got)
+	}
+
+	if !s.Equal(NewOrdered("a", "f")) {
+		t.Error("sets should be equal")
+	}
+	if !s.Set().Equal(New("a", "f")) {
+		t.Errorf("got %v, want [a f]", s.Set())
+	}
+
+	var (
+		errStop = errors.New("stop")
+		seen    []string
+	)
+	err := s.Eachx(func(val string) error {
+		seen = append(seen, val)
+		return errStop
+	})
+	if !errors.Is(err, errStop) || !slices.Equal(seen, []string{"f"}) {
+		t.Errorf("got %v, %v; want [f], %v", seen, err, errStop)
+	}
+
+	var empty *Ordered[string]
+	if empty.Len() != 0 || empty.Has("a") || empty.Index("a") != -1 || empty.Slice() != nil {
+		t.Error("nil set should be empty")
+	}
+}
+
+func TestOrderedPositions(t *testing.T) {
+	var (
+		s     Ordered[int]
+		model []int
+	)
+	for i := range 500 {
+		if i%3 == 2 && len(model) > 0 {
+			val := model[(i*7)%len(model)]
+			s.Del(val)
+			model = slices.DeleteFunc(model, func(v int) bool { return v == val })
+		} else {
+			s.Add(i)
+			model = append(model, i)
+		}
+		if i%25 != 0 {
+			continue
+		}
+		for j, val := range model {
+			if got := s.At(j); got != val {
+				t.Fatalf("after step %d: got %d at %d, want %d", i, got, j, val)
+			}
+			if got := s.Index(val); got != j {
+				t.Fatalf("after step %d: got index %d for %d, want %d", i, got, val, j)
+			}
+		}
+	}
+
+	// Positional lookups are read-only and may run concurrently.
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j, val := range model {
+				if s.At(j) != val || s.Index(val) != j {
+					t.Errorf("wrong position for %d", val)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("At out of range did not panic")
+		}
+	}()
+	s.At(s.Len())
+}
@@ -0,0 +1,338 @@
+package set
+
+import (
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"slices"
+)
+
+// Persistent is an immutable set of elements of type T.
+// Instead of changing a set in place,
+// [Persistent.With] and [Persistent.Without] produce new versions of it,
+// leaving the original unchanged.
+// New versions share most of their structure with the versions they came from,
+// so keeping many versions is cheap.
+//
+// A Persistent set is a hash array mapped trie.
+// Membership tests, additions, and removals take O(log n) time
+// with a large base (32),
+// which in practice is nearly constant.
+//
+// Because no version is ever modified,
+// any version may be read concurrently by multiple goroutines.
+//
+// The zero value of Persistent is an empty set ready to use.
+type Persistent[T comparable] struct {
+	root *hamtNode[T]
+	n    int
+}
+
+// hamtSeed is the hash seed for all Persistent sets in this process.
+// Versions must agree on their seed in order to share structure.
+var hamtSeed = maphash.MakeSeed()
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+// hamtNode is an interior node of the trie.
+// Bit i of bitmap is set if there is an entry for the hash bits i at this level.
+// Entries are stored densely in the order of their bits.
+type hamtNode[T comparable] struct {
+	bitmap  uint32
+	entries []hamtEntry[T]
+}
+
+// hamtEntry is either a pointer to a child node,
+// or (if child is nil)
+// a leaf holding the values with a particular full hash.
+// Normally there is only one such value,
+// but there may be more in case of a hash collision.
+type hamtEntry[T comparable] struct {
+	child *hamtNode[T]
+	hash  uint64
+	vals  []T
+}
+
+// NewPersistent produces a new persistent set containing the given values.
+func NewPersistent[T comparable](vals ...T) Persistent[T] {
+	var s Persistent[T]
+	return s.With(vals...)
+}
+
+// CollectPersistent collects the members of the given sequence into a new persistent set.
+func CollectPersistent[T comparable](inp iter.Seq[T]) Persistent[T] {
+	var s Persistent[T]
+	for val := range inp {
+		s = s.With(val)
+	}
+	return s
+}
+
+// PersistentOf produces a new persistent set with the same members as the given set.
+// The input may be nil.
+func PersistentOf[T comparable](s Of[T]) Persistent[T] {
+	return CollectPersistent(s.All())
+}
+
+// With produces a version of s that also contains the given values.
+// The set s is unchanged.
+func (s Persistent[T]) With(vals ...T) Persistent[T] {
+	for _, val := range vals {
+		var (
+			h     = maphash.Comparable(hamtSeed, val)
+			added bool
+		)
+		s.root, added = s.root.with(h, val, 0)
+		if added {
+			s.n++
+		}
+	}
+	return s
+}
+
+// Without produces a version of s that does not contain the given values.
+// The set s is unchanged.
+func (s Persistent[T]) Without(vals ...T) Persistent[T] {
+	for _, val := range vals {
+		var (
+			h       = maphash.Comparable(hamtSeed, val)
+			removed bool
+		)
+		s.root, removed = s.root.without(h, val, 0)
+		if removed {
+			s.n--
+		}
+	}
+	return s
+}
+
+// Has tells whether the given value is in the set.
+func (s Persistent[T]) Has(val T) bool {
+	h := maphash.Comparable(hamtSeed, val)
+	for node, shift := s.root, 0; node != nil; shift += hamtBits {
+		e, ok := node.find(h, shift)
+		if !ok {
+			return false
+		}
+		if e.child == nil {
+			return e.hash == h && slices.Contains(e.vals, val)
+		}
+		node = e.child
+	}
+	return false
+}
+
+// Len tells the number of distinct values in the set.
+func (s Persistent[T]) Len() int {
+	return s.n
+}
+
+// Equal tests whether the set has the same membership as another.
+func (s Persistent[T]) Equal(other Persistent[T]) bool {
+	if s.n != other.n {
+		return false
+	}
+	if s.root == other.root {
+		return true
+	}
+	for val := range s.All() {
+		if !other.Has(val) {
+			return false
+		}
+	}
+	return true
+}
+
+// All produces an iterator over the members of the set,
+// in an indeterminate order.
+func (s Persistent[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.each(yield)
+	}
+}
+
+// Slice produces a new slice of the elements in the set.
+// The slice is in an indeterminate order.
+func (s Persistent[T]) Slice() []T {
+	if s.n == 0 {
+		return nil
+	}
+	return slices.Collect(s.All())
+}
+
+// Set produces a new [Of] with the same members as s.
+func (s Persistent[T]) Set() Of[T] {
+	return Collect(s.All())
+}
+
+// find locates the entry in node for the given hash at the given level.
+func (node *hamtNode[T]) find(h uint64, shift int) (hamtEntry[T], bool) {
+	bit := uint32(1) << ((h >> shift) & hamtMask)
+	if node.bitmap&bit == 0 {
+		return hamtEntry[T]{}, false
+	}
+	return node.entries[node.pos(bit)], true
+}
+
+// pos tells the index in node.entries for the given bit.
+func (node *hamtNode[T]) pos(bit uint32) int {
+	return bits.OnesCount32(node.bitmap & (bit - 1))
+}
+
+// with produces a copy of node (which may be nil) that contains val.
+// The boolean result tells whether val was added;
+// if it is false,
+// node itself is returned.
+func (node *hamtNode[T]) with(h uint64, val T, shift int) (*hamtNode[T], bool) {
+	leaf := hamtEntry[T]{hash: h, vals: []T{val}}
+	if node == nil {
+		return &hamtNode[T]{
+			bitmap:  1 << ((h >> shift) & hamtMask),
+			entries: []hamtEntry[T]{leaf},
+		}, true
+	}
+
+	bit := uint32(1) << ((h >> shift) & hamtMask)
+	pos := node.pos(bit)
+	if node.bitmap&bit == 0 {
+		return &hamtNode[T]{
+			bitmap:  node.bitmap | bit,
+			entries: slices.Insert(slices.Clone(node.entries), pos, leaf),
+		}, true
+	}
+
+	var (
+		e       = node.entries[pos]
+		replace hamtEntry[T]
+	)
+	switch {
+	case e.child != nil:
+		child, added := e.child.with(h, val, shift+hamtBits)
+		if !added {
+			return node, false
+		}
+		replace = hamtEntry[T]{child: child}
+
+	case e.hash == h:
+		if slices.Contains(e.vals, val) {
+			return node, false
+		}
+		replace = hamtEntry[T]{hash: h, vals: append(slices.Clip(e.vals), val)}
+
+	default:
+		replace = hamtEntry[T]{child: hamtMerge(e, leaf, shift+hamtBits)}
+	}
+
+	entries := slices.Clone(node.entries)
+	entries[pos] = replace
+	return &hamtNode[T]{bitmap: node.bitmap, entries: entries}, true
+}
+
+// hamtMerge produces a new node containing leaves a and b,
+// which have different hashes.
+func hamtMerge[T comparable](a, b hamtEntry[T], shift int) *hamtNode[T] {
+	ia, ib := (a.hash>>shift)&hamtMask, (b.hash>>shift)&hamtMask
+	if ia == ib {
+		return &hamtNode[T]{
+			bitmap:  1 << ia,
+			entries: []hamtEntry[T]{{child: hamtMerge(a, b, shift+hamtBits)}},
+		}
+	}
+	if ia > ib {
+		a, b = b, a
+	}
+	return &hamtNode[T]{
+		bitmap:  1<<ia | 1<<ib,
+		entries: []hamtEntry[T]{a, b},
+	}
+}
+
+// without produces a copy of node (which may be nil) that does not contain val.
+// The result is nil if the copy would be empty.
+// The boolean result tells whether val was removed;
+// if it is false,
+// node itself is returned.
+func (node *hamtNode[T]) without(h uint64, val T, shift int) (*hamtNode[T], bool) {
+	if node == nil {
+		return nil, false
+	}
+	bit := uint32(1) << ((h >> shift) & hamtMask)
+	if node.bitmap&bit == 0 {
+		return node, false
+	}
+
+	var (
+		pos     = node.pos(bit)
+		e       = node.entries[pos]
+		replace hamtEntry[T]
+		drop    bool
+	)
+	if e.child != nil {
+		child, removed := e.child.without(h, val, shift+hamtBits)
+		if !removed {
+			return node, false
+		}
+		switch {
+		case child == nil:
+			drop = true
+		case len(child.entries) == 1 && child.entries[0].child == nil:
+			// Pull a lone leaf up into this node.
+			replace = child.entries[0]
+		default:
+			replace = hamtEntry[T]{child: child}
+		}
+	} else {
+		if e.hash != h {
+			return node, false
+		}
+		i := slices.Index(e.vals, val)
+		if i < 0 {
+			return node, false
+		}
+		if len(e.vals) == 1 {
+			drop = true
+		} else {
+			replace = hamtEntry[T]{hash: h, vals: slices.Delete(slices.Clone(e.vals), i, i+1)}
+		}
+	}
+
+	if drop {
+		if len(node.entries) == 1 {
+			return nil, true
+		}
+		return &hamtNode[T]{
+			bitmap:  node.bitmap &^ bit,
+			entries: slices.Delete(slices.Clone(node.entries), pos, pos+1),
+		}, true
+	}
+
+	entries := slices.Clone(node.entries)
+	entries[pos] = replace
+	return &hamtNode[T]{bitmap: node.bitmap, entries: entries}, true
+}
+
+// each calls yield on each value in the subtree rooted at node
+// (which may be nil).
+// It returns false if yield does.
+func (node *hamtNode[T]) each(yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+	for _, e := range node.entries {
+		if e.child != nil {
+			if !e.child.each(yield) {
+				return false
+			}
+			continue
+		}
+		for _, val := range e.vals {
+			if !yield(val) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package set
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestPersistent(t *testing.T) {
+	var empty Persistent[int]
+	if empty.Len() != 0 || empty.Has(0) || empty.Slice() != nil {
+		t.Error("zero value should be empty")
+	}
+
+	s1 := NewPersistent(1, 2, 3)
+	s2 := s1.With(4, 2)
+	s3 := s2.Without(1, 100)
+
+	if got := slices.Sorted(s1.All()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("s1: got %v, want [1 2 3]", got)
+	}
+	if got := slices.Sorted(s2.All()); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("s2: got %v, want [1 2 3 4]", got)
+	}
+	if got := slices.Sorted(s3.All()); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Errorf("s3: got %v, want [2 3 4]", got)
+	}
+	if s1.Len() != 3 || s2.Len() != 4 || s3.Len() != 3 {
+		t.Errorf("got lens %d, %d, %d; want 3, 4, 3", s1.Len(), s2.Len(), s3.Len())
+	}
+	if !s3.Equal(NewPersistent(4, 3, 2)) || s3.Equal(s1) {
+		t.Error("wrong equality")
+	}
+
+	if !PersistentOf(New(5, 6)).Set().Equal(New(5, 6)) {
+		t.Error("round trip through Of failed")
+	}
+}
+
+func TestPersistentRandom(t *testing.T) {
+	var (
+		rng      = rand.New(rand.NewPCG(1, 2))
+		model    = New[int]()
+		s        Persistent[int]
+		versions []Persistent[int]
+		models   []Of[int]
+	)
+	for i := 0; i < 5000; i++ {
+		val := rng.IntN(2000)
+		if rng.IntN(3) == 0 {
+			s = s.Without(val)
+			model.Del(val)
+		} else {
+			s = s.With(val)
+			model.Add(val)
+		}
+		if i%500 == 0 {
+			versions = append(versions, s)
+			models = append(models, Collect(model.All()))
+		}
+	}
+
+	if !s.Set().Equal(model) {
+		t.Fatalf("got %d members, want %d", s.Len(), model.Len())
+	}
+	for val := range 2000 {
+		if s.Has(val) != model.Has(val) {
+			t.Errorf("Has(%d) = %v, want %v", val, s.Has(val), model.Has(val))
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i, v := range versions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !v.Set().Equal(models[i]) || v.Len() != models[i].Len() {
+				t.Errorf("version %d changed", i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for val := range s.All() {
+		s = s.Without(val)
+	}
+	if s.Len() != 0 || s.root != nil {
+		t.Errorf("got %d members, want 0", s.Len())
+	}
+}
+
+func TestPersistentCollisions(t *testing.T) {
+	var root *hamtNode[string]
+	root, _ = root.with(42, "a", 0)
+	root, _ = root.with(42, "b", 0)
+	root, _ = root.with(42|1<<40, "c", 0)
+	if added := func() bool { _, added := root.with(42, "b", 0); return added }(); added {
+		t.Error("re-adding b reported a change")
+	}
+
+	var got []string
+	root.each(func(val string) bool {
+		got = append(got, val)
+		return true
+	})
+	slices.Sort(got)
+	if !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("got %v, want [a b c]", got)
+	}
+
+	root, _ = root.without(42, "a", 0)
+	root, _ = root.without(42|1<<40, "c", 0)
+	if len(root.entries) != 1 || root.entries[0].child != nil || !slices.Equal(root.entries[0].vals, []string{"b"}) {
+		t.Errorf("got %+v, want a single leaf [b]", root)
+	}
+}
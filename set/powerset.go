@@ -0,0 +1,91 @@
+package set
+
+import "iter"
+
+// PowerSet produces an iterator over all the subsets of s,
+// from the empty set to s itself.
+// The subsets are produced lazily,
+// each as a new set.
+// The set s may be nil.
+//
+// If T is an integer, floating-point, or string type,
+// the order of subsets is deterministic:
+// with the members of s sorted as m0, m1, m2, ...,
+// subset number i contains mj if and only if bit j of i is set.
+// Otherwise the order is indeterminate.
+func PowerSet[T comparable](s Of[T]) iter.Seq[Of[T]] {
+	return func(yield func(Of[T]) bool) {
+		var (
+			vals = s.sortedIfOrdered()
+			bits = make([]bool, len(vals))
+		)
+		for {
+			subset := New[T]()
+			for i, b := range bits {
+				if b {
+					subset.Add(vals[i])
+				}
+			}
+			if !yield(subset) {
+				return
+			}
+
+			// Increment the binary counter in bits.
+			i := 0
+			for ; i < len(bits) && bits[i]; i++ {
+				bits[i] = false
+			}
+			if i == len(bits) {
+				return
+			}
+			bits[i] = true
+		}
+	}
+}
+
+// Product produces an iterator over the cartesian product of the given sets:
+// every slice whose element i is a member of sets[i].
+// The slices are produced lazily,
+// each as a new slice.
+// If any set is empty (or nil),
+// the product is empty.
+// The product of no sets is a single empty slice.
+//
+// If T is an integer, floating-point, or string type,
+// the slices are produced in lexicographic order.
+// Otherwise the order is indeterminate.
+func Product[T comparable](sets ...Of[T]) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		vals := make([][]T, 0, len(sets))
+		for _, s := range sets {
+			if s.Len() == 0 {
+				return
+			}
+			vals = append(vals, s.sortedIfOrdered())
+		}
+
+		idx := make([]int, len(vals))
+		for {
+			tuple := make([]T, len(vals))
+			for i, j := range idx {
+				tuple[i] = vals[i][j]
+			}
+			if !yield(tuple) {
+				return
+			}
+
+			// Advance idx like an odometer, rightmost position fastest.
+			i := len(idx) - 1
+			for ; i >= 0; i-- {
+				idx[i]++
+				if idx[i] < len(vals[i]) {
+					break
+				}
+				idx[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package set
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestPowerSet(t *testing.T) {
+	var got []string
+	for subset := range PowerSet(New("c", "a", "b")) {
+		got = append(got, fmt.Sprint(slices.Sorted(subset.All())))
+	}
+	want := []string{"[]", "[a]", "[b]", "[a b]", "[c]", "[a c]", "[b c]", "[a b c]"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	var n int
+	for subset := range PowerSet[int](nil) {
+		if subset.Len() != 0 {
+			t.Errorf("got %v, want empty subset", subset)
+		}
+		n++
+	}
+	if n != 1 {
+		t.Errorf("power set of empty set has %d members, want 1", n)
+	}
+
+	n = 0
+	for range PowerSet(New(1, 2, 3, 4, 5)) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+}
+
+func TestProduct(t *testing.T) {
+	var got [][]int
+	for tuple := range Product(New(2, 1), New(10), New(300, 100, 200)) {
+		got = append(got, tuple)
+	}
+	want := [][]int{
+		{1, 10, 100}, {1, 10, 200}, {1, 10, 300},
+		{2, 10, 100}, {2, 10, 200}, {2, 10, 300},
+	}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for range Product(New(1, 2), nil) {
+		t.Error("product with an empty set should be empty")
+	}
+
+	got = nil
+	for tuple := range Product[int]() {
+		got = append(got, tuple)
+	}
+	if len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("got %v, want [[]]", got)
+	}
+}
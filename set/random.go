@@ -0,0 +1,138 @@
+package set
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+// Pop removes and returns an arbitrary member of the set.
+// The boolean result is false if the set is empty.
+// The set may be nil.
+func (s Of[T]) Pop() (T, bool) {
+	for val := range s {
+		delete(s, val)
+		return val, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Random produces a member of the set chosen uniformly at random using rng.
+// The boolean result is false if the set is empty.
+// The set may be nil.
+//
+// If rng is nil,
+// the top-level functions of [math/rand/v2] are used,
+// and Random takes O(n) time with no allocation.
+// Otherwise,
+// if T is an integer, floating-point, or string type,
+// the result is determined by the state of rng,
+// so a seeded rng gives repeatable results.
+// This requires sorting a copy of the set's members,
+// which takes O(n log n) time and O(n) space.
+func (s Of[T]) Random(rng *rand.Rand) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	if rng != nil && isOrdered[T]() {
+		vals := s.sortedIfOrdered()
+		return vals[rng.IntN(len(vals))], true
+	}
+
+	i := randIntN(rng, len(s))
+	for val := range s {
+		if i == 0 {
+			return val, true
+		}
+		i--
+	}
+	panic("unreachable")
+}
+
+// Sample produces k distinct members of the set chosen uniformly at random using rng,
+// in random order.
+// If k is greater than the size of the set,
+// all members are produced.
+// The set may be nil.
+//
+// If rng is nil,
+// the top-level functions of [math/rand/v2] are used,
+// and Sample takes O(n) time and O(k) space.
+// Otherwise,
+// if T is an integer, floating-point, or string type,
+// the result is determined by the state of rng,
+// so a seeded rng gives repeatable results.
+// This requires sorting a copy of the set's members,
+// which takes O(n log n) time and O(n) space.
+func (s Of[T]) Sample(rng *rand.Rand, k int) []T {
+	k = min(k, len(s))
+	if k <= 0 {
+		return nil
+	}
+	if rng == nil || !isOrdered[T]() {
+		// Reservoir sampling does not leave the result in random order,
+		// so finish with a shuffle.
+		vals := SampleSeq(rng, s.All(), k)
+		shuffle(rng, vals, len(vals))
+		return vals
+	}
+
+	vals := s.sortedIfOrdered()
+	shuffle(rng, vals, k)
+	return vals[:k]
+}
+
+// shuffle randomly permutes vals
+// until the first k elements are a uniform random sample,
+// in random order.
+// It is a partial Fisher-Yates shuffle.
+func shuffle[T any](rng *rand.Rand, vals []T, k int) {
+	for i := 0; i < k; i++ {
+		j := i + randIntN(rng, len(vals)-i)
+		vals[i], vals[j] = vals[j], vals[i]
+	}
+}
+
+// SampleSeq produces k values chosen uniformly at random using rng
+// from the values of the given sequence,
+// which is consumed in full.
+// If the sequence has fewer than k values,
+// all of them are produced.
+// It uses reservoir sampling,
+// so it needs memory only for the k values in the result,
+// not for the whole sequence.
+//
+// If rng is nil,
+// the top-level functions of [math/rand/v2] are used.
+// Otherwise,
+// if the sequence is deterministic,
+// the result is determined by the state of rng.
+func SampleSeq[T any](rng *rand.Rand, inp iter.Seq[T], k int) []T {
+	if k <= 0 {
+		return nil
+	}
+
+	var (
+		result []T
+		n      int
+	)
+	for val := range inp {
+		n++
+		if len(result) < k {
+			result = append(result, val)
+			continue
+		}
+		if j := randIntN(rng, n); j < k {
+			result[j] = val
+		}
+	}
+	return result
+}
+
+func randIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
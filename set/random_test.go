@@ -0,0 +1,126 @@
+package set
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestPop(t *testing.T) {
+	s := New(1, 2, 3)
+	got := New[int]()
+	for {
+		val, ok := s.Pop()
+		if !ok {
+			break
+		}
+		got.Add(val)
+	}
+	if !got.Equal(New(1, 2, 3)) || s.Len() != 0 {
+		t.Errorf("popped %v leaving %v, want [1 2 3] leaving []", got, s)
+	}
+
+	var empty Of[int]
+	if _, ok := empty.Pop(); ok {
+		t.Error("Pop on a nil set should fail")
+	}
+}
+
+func TestRandom(t *testing.T) {
+	s := New("a", "b", "c", "d", "e")
+
+	var first []string
+	for i := 0; i < 2; i++ {
+		rng := rand.New(rand.NewPCG(1, 2))
+		var got []string
+		for j := 0; j < 10; j++ {
+			val, ok := s.Random(rng)
+			if !ok || !s.Has(val) {
+				t.Fatalf("got %q (%v), want a member", val, ok)
+			}
+			got = append(got, val)
+		}
+		if i == 0 {
+			first = got
+		} else if !slices.Equal(got, first) {
+			t.Errorf("got %v, then %v with the same seed", first, got)
+		}
+	}
+
+	var empty Of[string]
+	if _, ok := empty.Random(nil); ok {
+		t.Error("Random on a nil set should fail")
+	}
+	if _, ok := s.Random(nil); !ok {
+		t.Error("Random with a nil rng should succeed")
+	}
+
+	seen := New[string]()
+	for i := 0; i < 1000 && seen.Len() < s.Len(); i++ {
+		val, _ := s.Random(nil)
+		seen.Add(val)
+	}
+	if !seen.Equal(s) {
+		t.Errorf("got %v from repeated calls, want all members", seen)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { s.Random(nil) }); allocs != 0 {
+		t.Errorf("got %v allocations with a nil rng, want 0", allocs)
+	}
+}
+
+func TestSample(t *testing.T) {
+	s := New(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+
+	got1 := s.Sample(rand.New(rand.NewPCG(3, 4)), 4)
+	got2 := s.Sample(rand.New(rand.NewPCG(3, 4)), 4)
+	if !slices.Equal(got1, got2) {
+		t.Errorf("got %v, then %v with the same seed", got1, got2)
+	}
+	if len(got1) != 4 || New(got1...).Len() != 4 || !New(got1...).IsSubset(s) {
+		t.Errorf("got %v, want 4 distinct members", got1)
+	}
+
+	if got := s.Sample(nil, 4); len(got) != 4 || New(got...).Len() != 4 || !New(got...).IsSubset(s) {
+		t.Errorf("got %v, want 4 distinct members", got)
+	}
+	if got := s.Sample(nil, 100); !New(got...).Equal(s) {
+		t.Errorf("got %v, want all members", got)
+	}
+	if got := s.Sample(nil, 0); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestSampleSeq(t *testing.T) {
+	var (
+		inp  = slices.Values([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+		got1 = SampleSeq(rand.New(rand.NewPCG(5, 6)), inp, 3)
+		got2 = SampleSeq(rand.New(rand.NewPCG(5, 6)), inp, 3)
+	)
+	if !slices.Equal(got1, got2) {
+		t.Errorf("got %v, then %v with the same seed", got1, got2)
+	}
+	if len(got1) != 3 || New(got1...).Len() != 3 {
+		t.Errorf("got %v, want 3 distinct values", got1)
+	}
+
+	if got := SampleSeq(nil, slices.Values([]int{1, 2}), 5); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+
+	// Each value should be chosen about equally often.
+	var (
+		rng    = rand.New(rand.NewPCG(7, 8))
+		counts = make(map[int]int)
+	)
+	for i := 0; i < 10000; i++ {
+		for _, val := range SampleSeq(rng, inp, 2) {
+			counts[val]++
+		}
+	}
+	for val, count := range counts {
+		if count < 1700 || count > 2300 {
+			t.Errorf("value %d chosen %d times, want about 2000", val, count)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"sort"
+)
+
+// Interval is the half-open interval of values v with Lo <= v < Hi.
+// It is empty if Lo >= Hi.
+type Interval[T cmp.Ordered] struct {
+	Lo, Hi T
+}
+
+// Has tells whether val is in the interval.
+func (iv Interval[T]) Has(val T) bool {
+	return iv.Lo <= val && val < iv.Hi
+}
+
+// Empty tells whether the interval contains no values.
+func (iv Interval[T]) Empty() bool {
+	return iv.Lo >= iv.Hi
+}
+
+// Ranges is a set of ordered values represented as a list of intervals,
+// for sets too large to enumerate member by member,
+// such as ranges of IP addresses or time windows.
+//
+// The intervals are half-open (see [Interval]),
+// and are kept sorted, non-overlapping, and normalized:
+// overlapping or adjacent intervals are merged,
+// so [1, 3) and [3, 5) become [1, 5).
+//
+// Because every interval excludes its upper bound,
+// a Ranges cannot contain the greatest value of a bounded type T.
+// For example, a Ranges[uint16] cannot contain port 65535,
+// and a Ranges[uint32] of IPv4 addresses cannot contain 255.255.255.255.
+// To represent such values,
+// use a wider type for T,
+// such as Ranges[uint32] for ports or Ranges[uint64] for IPv4 addresses.
+//
+// The zero value of Ranges is an empty set ready to use.
+// A nil *Ranges may be used for read-only operations,
+// where it behaves as an empty set.
+type Ranges[T cmp.Ordered] struct {
+	ivs []Interval[T]
+}
+
+// NewRanges produces a new set containing the values in the given intervals.
+func NewRanges[T cmp.Ordered](ivs ...Interval[T]) *Ranges[T] {
+	r := new(Ranges[T])
+	for _, iv := range ivs {
+		r.AddRange(iv.Lo, iv.Hi)
+	}
+	return r
+}
+
+func (r *Ranges[T]) intervals() []Interval[T] {
+	if r == nil {
+		return nil
+	}
+	return r.ivs
+}
+
+// AddRange adds the values v with lo <= v < hi to the set.
+// Note that hi itself is never added
+// (see [Ranges] for the consequences for bounded types).
+func (r *Ranges[T]) AddRange(lo, hi T) {
+	if lo >= hi {
+		return
+	}
+
+	// Intervals i through j-1 overlap or touch [lo, hi).
+	i := sort.Search(len(r.ivs), func(k int) bool { return r.ivs[k].Hi >= lo })
+	j := sort.Search(len(r.ivs), func(k int) bool { return r.ivs[k].Lo > hi })
+	if i < j {
+		lo = min(lo, r.ivs[i].Lo)
+		hi = max(hi, r.ivs[j-1].Hi)
+	}
+	r.ivs = slices.Replace(r.ivs, i, j, Interval[T]{Lo: lo, Hi: hi})
+}
+
+// DelRange removes the values v with lo <= v < hi from the set.
+func (r *Ranges[T]) DelRange(lo, hi T) {
+	if lo >= hi {
+		return
+	}
+
+	// Intervals i through j-1 overlap [lo, hi).
+	i := sort.Search(len(r.ivs), func(k int) bool { return r.ivs[k].Hi > lo })
+	j := sort.Search(len(r.ivs), func(k int) bool { return r.ivs[k].Lo >= hi })
+	if i >= j {
+		return
+	}
+
+	var keep []Interval[T]
+	if first := r.ivs[i]; first.Lo < lo {
+		keep = append(keep, Interval[T]{Lo: first.Lo, Hi: lo})
+	}
+	if last := r.ivs[j-1]; last.Hi > hi {
+		keep = append(keep, Interval[T]{Lo: hi, Hi: last.Hi})
+	}
+	r.ivs = slices.Replace(r.ivs, i, j, keep...)
+}
+
+// Has tells whether the given value is in the set.
+// The set may be nil.
+func (r *Ranges[T]) Has(val T) bool {
+	ivs := r.intervals()
+	k := sort.Search(len(ivs), func(k int) bool { return ivs[k].Hi > val })
+	return k < len(ivs) && ivs[k].Lo <= val
+}
+
+// NumIntervals tells the number of intervals in the set.
+// This is not the number of values in the set.
+// The set may be nil.
+func (r *Ranges[T]) NumIntervals() int {
+	return len(r.intervals())
+}
+
+// Equal tests whether the set has the same membership as another.
+// Either set may be nil.
+func (r *Ranges[T]) Equal(other *Ranges[T]) bool {
+	return slices.Equal(r.intervals(), other.intervals())
+}
+
+// All produces an iterator over the intervals of the set,
+// in ascending order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (r *Ranges[T]) All() iter.Seq[Interval[T]] {
+	return slices.Values(r.intervals())
+}
+
+// Intervals produces a new slice of the intervals of the set,
+// in ascending order.
+func (r *Ranges[T]) Intervals() []Interval[T] {
+	return slices.Clone(r.intervals())
+}
+
+// Gaps produces an iterator over the intervals of values v with lo <= v < hi
+// that are not in the set,
+// in ascending order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (r *Ranges[T]) Gaps(lo, hi T) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		for _, iv := range r.intervals() {
+			if lo >= hi {
+				return
+			}
+			if iv.Hi <= lo {
+				continue
+			}
+			if iv.Lo >= hi {
+				break
+			}
+			if lo < iv.Lo {
+				if !yield(Interval[T]{Lo: lo, Hi: iv.Lo}) {
+					return
+				}
+			}
+			lo = iv.Hi
+		}
+		if lo < hi {
+			yield(Interval[T]{Lo: lo, Hi: hi})
+		}
+	}
+}
+
+// IntersectRanges produces a new set containing only values that appear in all the given sets.
+// The input may include nils,
+// representing empty sets
+// and therefore producing an empty (but non-nil) intersection.
+func IntersectRanges[T cmp.Ordered](sets ...*Ranges[T]) *Ranges[T] {
+	result := new(Ranges[T])
+	if len(sets) == 0 {
+		return result
+	}
+	result.ivs = slices.Clone(sets[0].intervals())
+	for _, s := range sets[1:] {
+		var (
+			a, b = result.ivs, s.intervals()
+			ivs  []Interval[T]
+		)
+		for len(a) > 0 && len(b) > 0 {
+			iv := Interval[T]{Lo: max(a[0].Lo, b[0].Lo), Hi: min(a[0].Hi, b[0].Hi)}
+			if !iv.Empty() {
+				ivs = append(ivs, iv)
+			}
+			if a[0].Hi < b[0].Hi {
+				a = a[1:]
+			} else {
+				b = b[1:]
+			}
+		}
+		result.ivs = ivs
+	}
+	return result
+}
+
+// UnionRanges produces a new set containing all the values in all the given sets.
+// The input may include nils,
+// representing empty sets.
+// The result is never nil (but may be empty).
+func UnionRanges[T cmp.Ordered](sets ...*Ranges[T]) *Ranges[T] {
+	result := new(Ranges[T])
+	for _, s := range sets {
+		for _, iv := range s.intervals() {
+			result.AddRange(iv.Lo, iv.Hi)
+		}
+	}
+	return result
+}
+
+// DiffRanges produces a new set containing the values in r1 that are not also in r2.
+// Either set may be nil.
+// The result is never nil (but may be empty).
+func DiffRanges[T cmp.Ordered](r1, r2 *Ranges[T]) *Ranges[T] {
+	result := &Ranges[T]{ivs: slices.Clone(r1.intervals())}
+	for _, iv := range r2.intervals() {
+		result.DelRange(iv.Lo, iv.Hi)
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+package set
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+type iv = Interval[int]
+
+func TestRanges(t *testing.T) {
+	r := NewRanges(iv{10, 20}, iv{30, 40}, iv{5, 5})
+	if got := r.Intervals(); !slices.Equal(got, []iv{{10, 20}, {30, 40}}) {
+		t.Errorf("got %v, want [{10 20} {30 40}]", got)
+	}
+
+	r.AddRange(20, 25) // adjacent, coalesces
+	r.AddRange(50, 60)
+	if got := r.Intervals(); !slices.Equal(got, []iv{{10, 25}, {30, 40}, {50, 60}}) {
+		t.Errorf("got %v, want [{10 25} {30 40} {50 60}]", got)
+	}
+
+	r.AddRange(22, 55) // spans several
+	if got := slices.Collect(r.All()); !slices.Equal(got, []iv{{10, 60}}) {
+		t.Errorf("got %v, want [{10 60}]", got)
+	}
+
+	r.DelRange(20, 30)
+	r.DelRange(55, 100)
+	r.DelRange(0, 12)
+	if got := r.Intervals(); !slices.Equal(got, []iv{{12, 20}, {30, 55}}) {
+		t.Errorf("got %v, want [{12 20} {30 55}]", got)
+	}
+	if r.NumIntervals() != 2 {
+		t.Errorf("got %d intervals, want 2", r.NumIntervals())
+	}
+
+	for val, want := range map[int]bool{11: false, 12: true, 19: true, 20: false, 29: false, 30: true, 54: true, 55: false} {
+		if got := r.Has(val); got != want {
+			t.Errorf("Has(%d): got %v, want %v", val, got, want)
+		}
+	}
+
+	if got := slices.Collect(r.Gaps(0, 100)); !slices.Equal(got, []iv{{0, 12}, {20, 30}, {55, 100}}) {
+		t.Errorf("got gaps %v, want [{0 12} {20 30} {55 100}]", got)
+	}
+	if got := slices.Collect(r.Gaps(15, 35)); !slices.Equal(got, []iv{{20, 30}}) {
+		t.Errorf("got gaps %v, want [{20 30}]", got)
+	}
+	if got := slices.Collect(r.Gaps(31, 40)); len(got) != 0 {
+		t.Errorf("got gaps %v, want none", got)
+	}
+
+	var empty *Ranges[int]
+	if empty.Has(0) || empty.NumIntervals() != 0 || !empty.Equal(new(Ranges[int])) {
+		t.Error("nil set should be empty")
+	}
+	if got := slices.Collect(empty.Gaps(1, 2)); !slices.Equal(got, []iv{{1, 2}}) {
+		t.Errorf("got gaps %v, want [{1 2}]", got)
+	}
+}
+
+func TestRangesTime(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	r := new(Ranges[int64])
+	r.AddRange(base, base+3600)
+	r.AddRange(base+3600, base+7200)
+	if r.NumIntervals() != 1 || !r.Has(base+3600) {
+		t.Errorf("got %v, want a single two-hour interval", r.Intervals())
+	}
+
+	// A wider type makes room for the greatest 16-bit port.
+	ports := NewRanges(Interval[uint32]{Lo: 49152, Hi: 65536})
+	if !ports.Has(65535) || ports.Has(65536) {
+		t.Error("wrong port membership")
+	}
+
+	f := NewRanges(Interval[float64]{0.5, 1.5})
+	if !f.Has(1.49) || f.Has(1.5) {
+		t.Error("wrong float membership")
+	}
+}
+
+func TestRangesAlgebra(t *testing.T) {
+	var (
+		r1 = NewRanges(iv{0, 10}, iv{20, 30})
+		r2 = NewRanges(iv{5, 25})
+		r3 = NewRanges(iv{8, 22})
+	)
+
+	if got := IntersectRanges(r1, r2, r3).Intervals(); !slices.Equal(got, []iv{{8, 10}, {20, 22}}) {
+		t.Errorf("got %v, want [{8 10} {20 22}]", got)
+	}
+	if got := IntersectRanges(r1, nil); got == nil || got.NumIntervals() != 0 {
+		t.Errorf("got %v, want empty", got)
+	}
+	if got := UnionRanges(r1, r2, nil).Intervals(); !slices.Equal(got, []iv{{0, 30}}) {
+		t.Errorf("got %v, want [{0 30}]", got)
+	}
+	if got := DiffRanges(r1, r2).Intervals(); !slices.Equal(got, []iv{{0, 5}, {25, 30}}) {
+		t.Errorf("got %v, want [{0 5} {25 30}]", got)
+	}
+	if got := DiffRanges(nil, r2); got == nil || got.NumIntervals() != 0 {
+		t.Errorf("got %v, want empty", got)
+	}
+}
@@ -0,0 +1,81 @@
+package set
+
+import "iter"
+
+// IntersectSeq produces an iterator over the items that appear in all the given sets,
+// in an indeterminate order.
+// Unlike [Intersect], it does not allocate a new set;
+// membership is computed as the iterator runs.
+// It iterates over the smallest of the given sets
+// and checks each member against the others.
+// The input may include nils,
+// representing empty sets.
+func IntersectSeq[T comparable](sets ...Of[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if len(sets) == 0 {
+			return
+		}
+		smallest := 0
+		for i, s := range sets {
+			if len(s) < len(sets[smallest]) {
+				smallest = i
+			}
+		}
+
+	OUTER:
+		for val := range sets[smallest] {
+			for i, s := range sets {
+				if i != smallest && !s.Has(val) {
+					continue OUTER
+				}
+			}
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// UnionSeq produces an iterator over the items in any of the given sets,
+// in an indeterminate order.
+// Each item is produced once,
+// even if it appears in more than one set.
+// Unlike [Union], it does not allocate a new set;
+// membership is computed as the iterator runs.
+// The input may include nils,
+// representing empty sets.
+func UnionSeq[T comparable](sets ...Of[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i, s := range sets {
+		INNER:
+			for val := range s {
+				for _, earlier := range sets[:i] {
+					if earlier.Has(val) {
+						continue INNER
+					}
+				}
+				if !yield(val) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// DiffSeq produces an iterator over the items in s1 that are not also in s2,
+// in an indeterminate order.
+// Unlike [Diff], it does not allocate a new set;
+// membership is computed as the iterator runs.
+// Either set may be nil.
+func DiffSeq[T comparable](s1, s2 Of[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for val := range s1 {
+			if s2.Has(val) {
+				continue
+			}
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package set
+
+import "testing"
+
+func TestSeqs(t *testing.T) {
+	var (
+		s1 = New(1, 2, 3, 4, 5)
+		s2 = New(4, 5, 6, 7, 8)
+		s3 = New(5, 8)
+	)
+
+	cases := []struct {
+		name string
+		got  Of[int]
+		want Of[int]
+	}{
+		{"intersect", Collect(IntersectSeq(s1, s2, s3)), New(5)},
+		{"intersect_nil", Collect(IntersectSeq(s1, nil)), New[int]()},
+		{"intersect_none", Collect(IntersectSeq[int]()), New[int]()},
+		{"union", Collect(UnionSeq(s1, s2, nil, s3)), New(1, 2, 3, 4, 5, 6, 7, 8)},
+		{"diff", Collect(DiffSeq(s1, s2)), New(1, 2, 3)},
+		{"diff_nil", Collect(DiffSeq(nil, s2)), New[int]()},
+	}
+	for _, tc := range cases {
+		if !tc.got.Equal(tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+
+	var n int
+	for range UnionSeq(s1, s2, s3) {
+		n++
+	}
+	if n != 8 {
+		t.Errorf("union produced %d values, want 8", n)
+	}
+
+	for range IntersectSeq(s1, s2) {
+		break // exercise early exit
+	}
+}
@@ -82,6 +82,84 @@ func (s Of[T]) Equal(other Of[T]) bool {
 	return true
 }
 
+// IsSubset tells whether every member of s is also a member of other.
+// Either set may be nil.
+func (s Of[T]) IsSubset(other Of[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for val := range s {
+		if !other.Has(val) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset tells whether every member of other is also a member of s.
+// Either set may be nil.
+func (s Of[T]) IsSuperset(other Of[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Disjoint tells whether s and other have no members in common.
+// Either set may be nil.
+func (s Of[T]) Disjoint(other Of[T]) bool {
+	return !s.Overlaps(other)
+}
+
+// Overlaps tells whether s and other have at least one member in common.
+// Either set may be nil.
+func (s Of[T]) Overlaps(other Of[T]) bool {
+	if len(s) > len(other) {
+		s, other = other, s
+	}
+	for val := range s {
+		if other.Has(val) {
+			return true
+		}
+	}
+	return false
+}
+
+// IntersectWith removes from s any members that do not appear in all the given sets.
+// The input may include nils,
+// representing empty sets.
+// The set s may be nil only if it is also empty.
+func (s Of[T]) IntersectWith(sets ...Of[T]) {
+	for val := range s {
+		for _, other := range sets {
+			if !other.Has(val) {
+				delete(s, val)
+				break
+			}
+		}
+	}
+}
+
+// UnionWith adds to s all the members of the given sets.
+// The input may include nils,
+// representing empty sets.
+func (s Of[T]) UnionWith(sets ...Of[T]) {
+	for _, other := range sets {
+		for val := range other {
+			s[val] = struct{}{}
+		}
+	}
+}
+
+// Subtract removes from s all the members of the given sets.
+// The input may include nils,
+// representing empty sets.
+// The set s may be nil.
+func (s Of[T]) Subtract(sets ...Of[T]) {
+	for _, other := range sets {
+		for val := range other {
+			delete(s, val)
+		}
+	}
+}
+
 // Each calls a function on each element of the set in an indeterminate order.
 // It is safe to add and remove items during a call to Each,
 // but that can affect the sequence of values seen later during the same Each call.
@@ -125,10 +203,28 @@ func (s Of[T]) Slice() []T {
 	return slices.Collect(s.All())
 }
 
+// Interface is the set of methods common to the set types in this package
+// that support adding and removing members:
+// [Of], [*Sorted], [*Sync], [*Bits], [*Ordered], [*Keyed], [*Trie], and [*Observable].
+// Code that accepts an Interface can work with any of them,
+// and with other implementations too.
+//
+// The package settest contains a conformance test
+// for checking that an implementation behaves like a set.
+type Interface[T any] interface {
+	Add(...T)
+	Del(...T)
+	Has(T) bool
+	Len() int
+	All() iter.Seq[T]
+}
+
 // Intersect produces a new set containing only items that appear in all the given sets.
 // The input may include nils,
 // representing empty sets
 // and therefore producing an empty (but non-nil) intersection.
+//
+// See [IntersectAny] for a version that works with any [Interface].
 func Intersect[T comparable](sets ...Of[T]) Of[T] {
 	result := New[T]()
 	if len(sets) == 0 {
@@ -154,6 +250,8 @@ func Intersect[T comparable](sets ...Of[T]) Of[T] {
 // The input may include nils,
 // representing empty sets.
 // The result is never nil (but may be empty).
+//
+// See [UnionAny] for a version that works with any [Interface].
 func Union[T comparable](sets ...Of[T]) Of[T] {
 	result := New[T]()
 	for _, s := range sets {
@@ -168,6 +266,8 @@ func Union[T comparable](sets ...Of[T]) Of[T] {
 // Diff produces a new set containing the items in s1 that are not also in s2.
 // Either set may be nil.
 // The result is never nil (but may be empty).
+//
+// See [DiffAny] for a version that works with any [Interface].
 func Diff[T comparable](s1, s2 Of[T]) Of[T] {
 	s := New[T]()
 	s1.Each(func(val T) {
@@ -177,3 +277,95 @@ func Diff[T comparable](s1, s2 Of[T]) Of[T] {
 	})
 	return s
 }
+
+// isEmpty tells whether s is a nil interface or has no members.
+func isEmpty[T any](s Interface[T]) bool {
+	return s == nil || s.Len() == 0
+}
+
+// IntersectAny produces a new set containing only items that appear in all the given sets,
+// which may be of different types implementing [Interface].
+// The input may include nils,
+// representing empty sets
+// and therefore producing an empty (but non-nil) intersection.
+func IntersectAny[T comparable](sets ...Interface[T]) Of[T] {
+	result := New[T]()
+	if len(sets) == 0 {
+		return result
+	}
+	smallest := 0
+	for i, s := range sets {
+		if isEmpty(s) {
+			return result
+		}
+		if s.Len() < sets[smallest].Len() {
+			smallest = i
+		}
+	}
+
+OUTER:
+	for val := range sets[smallest].All() {
+		for i, s := range sets {
+			if i != smallest && !s.Has(val) {
+				continue OUTER
+			}
+		}
+		result.Add(val)
+	}
+	return result
+}
+
+// UnionAny produces a new set containing all the items in all the given sets,
+// which may be of different types implementing [Interface].
+// The input may include nils,
+// representing empty sets.
+// The result is never nil (but may be empty).
+func UnionAny[T comparable](sets ...Interface[T]) Of[T] {
+	result := New[T]()
+	for _, s := range sets {
+		if isEmpty(s) {
+			continue
+		}
+		result.AddSeq(s.All())
+	}
+	return result
+}
+
+// DiffAny produces a new set containing the items in s1 that are not also in s2,
+// which may be of different types implementing [Interface].
+// Either set may be nil.
+// The result is never nil (but may be empty).
+func DiffAny[T comparable](s1, s2 Interface[T]) Of[T] {
+	s := New[T]()
+	if isEmpty(s1) {
+		return s
+	}
+	if isEmpty(s2) {
+		s.AddSeq(s1.All())
+		return s
+	}
+	for val := range s1.All() {
+		if !s2.Has(val) {
+			s.Add(val)
+		}
+	}
+	return s
+}
+
+// SymDiff produces a new set containing the items that are in exactly one of s1 and s2.
+// Either set may be nil.
+// The result is never nil (but may be empty).
+func SymDiff[T comparable](s1, s2 Of[T]) Of[T] {
+	s := New[T]()
+	s1.Each(func(val T) {
+		if !s2.Has(val) {
+			s.Add(val)
+		}
+	})
+	s2.Each(func(val T) {
+		if !s1.Has(val) {
+			s.Add(val)
+		}
+	})
+	return s
+}
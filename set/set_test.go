@@ -102,3 +102,143 @@ func TestCollect(t *testing.T) {
 		t.Errorf("got %v, want %v", got, want)
 	}
 }
+
+func TestRelations(t *testing.T) {
+	var (
+		a = New(1, 2, 3)
+		b = New(1, 2, 3, 4)
+		c = New(4, 5)
+		e Of[int]
+	)
+
+	cases := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"a subset b", a.IsSubset(b), true},
+		{"b subset a", b.IsSubset(a), false},
+		{"a subset a", a.IsSubset(a), true},
+		{"empty subset a", e.IsSubset(a), true},
+		{"a subset empty", a.IsSubset(e), false},
+		{"b superset a", b.IsSuperset(a), true},
+		{"a superset b", a.IsSuperset(b), false},
+		{"a superset empty", a.IsSuperset(e), true},
+		{"a disjoint c", a.Disjoint(c), true},
+		{"b disjoint c", b.Disjoint(c), false},
+		{"empty disjoint empty", e.Disjoint(e), true},
+		{"b overlaps c", b.Overlaps(c), true},
+		{"a overlaps c", a.Overlaps(c), false},
+		{"a overlaps empty", a.Overlaps(e), false},
+	}
+	for _, tc := range cases {
+		if tc.got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+}
+
+func TestSymDiff(t *testing.T) {
+	got := SymDiff(New(1, 2, 3), New(3, 4))
+	if !got.Equal(New(1, 2, 4)) {
+		t.Errorf("got %v, want [1 2 4]", got)
+	}
+	got = SymDiff(nil, New(3, 4))
+	if !got.Equal(New(3, 4)) {
+		t.Errorf("got %v, want [3 4]", got)
+	}
+}
+
+func TestInPlace(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+	s.IntersectWith(New(2, 3, 4, 5, 6), New(3, 4, 5))
+	if !s.Equal(New(3, 4, 5)) {
+		t.Errorf("after IntersectWith got %v, want [3 4 5]", s)
+	}
+
+	s.UnionWith(New(6, 7), nil)
+	if !s.Equal(New(3, 4, 5, 6, 7)) {
+		t.Errorf("after UnionWith got %v, want [3 4 5 6 7]", s)
+	}
+
+	s.Subtract(New(3, 7), nil)
+	if !s.Equal(New(4, 5, 6)) {
+		t.Errorf("after Subtract got %v, want [4 5 6]", s)
+	}
+
+	s.IntersectWith(nil)
+	if s.Len() != 0 {
+		t.Errorf("after IntersectWith(nil) got %v, want []", s)
+	}
+}
+
+var (
+	_ Interface[int]    = Of[int](nil)
+	_ Interface[int]    = (*Sorted[int])(nil)
+	_ Interface[int]    = (*Sync[int])(nil)
+	_ Interface[int]    = (*Bits[int])(nil)
+	_ Interface[int]    = (*Ordered[int])(nil)
+	_ Interface[int]    = (*Keyed[int, int])(nil)
+	_ Interface[string] = (*Trie[string])(nil)
+	_ Interface[int]    = (*Observable[int])(nil)
+)
+
+func TestInterfaceAlgebra(t *testing.T) {
+	// The Of-based functions can be instantiated with T alone.
+	union := Union[int]
+	if got := union(New(1), nil); !got.Equal(New(1)) {
+		t.Errorf("got %v, want [1]", got)
+	}
+	if got := Diff[int](nil, nil); got == nil || got.Len() != 0 {
+		t.Errorf("got %v, want []", got)
+	}
+
+	var (
+		a = NewSorted(1, 2, 3, 4)
+		b = NewSorted(3, 4, 5)
+	)
+	if got := IntersectAny(a, b); !got.Equal(New(3, 4)) {
+		t.Errorf("got %v, want [3 4]", got)
+	}
+	if got := UnionAny(a, b, nil); !got.Equal(New(1, 2, 3, 4, 5)) {
+		t.Errorf("got %v, want [1 2 3 4 5]", got)
+	}
+	if got := DiffAny(a, b); !got.Equal(New(1, 2)) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+
+	mixed := []Interface[int]{New(2, 3, 4), a, NewBits(0, 3, 4, 9), nil}
+	if got := IntersectAny(mixed[:3]...); !got.Equal(New(3, 4)) {
+		t.Errorf("got %v, want [3 4]", got)
+	}
+	if got := IntersectAny(mixed...); got.Len() != 0 {
+		t.Errorf("got %v, want []", got)
+	}
+	if got := UnionAny(mixed...); !got.Equal(New(0, 1, 2, 3, 4, 9)) {
+		t.Errorf("got %v, want [0 1 2 3 4 9]", got)
+	}
+	if got := DiffAny(mixed[2], mixed[3]); !got.Equal(New(0, 3, 4, 9)) {
+		t.Errorf("got %v, want [0 3 4 9]", got)
+	}
+
+	// Nil pointers of the package's set types behave as empty sets.
+	var (
+		nilSync       *Sync[int]
+		nilObservable *Observable[int]
+	)
+	if got := UnionAny(NewSync(1), nilSync); !got.Equal(New(1)) {
+		t.Errorf("got %v, want [1]", got)
+	}
+	if got := IntersectAny(NewSync(1), nilSync); got.Len() != 0 {
+		t.Errorf("got %v, want []", got)
+	}
+	if got := DiffAny(NewObservable(1, 2), nilObservable); !got.Equal(New(1, 2)) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+	if got := DiffAny(nilObservable, NewObservable(1, 2)); got.Len() != 0 {
+		t.Errorf("got %v, want []", got)
+	}
+	if got := UnionAny[int](nilSync, nilObservable, nil); got == nil || got.Len() != 0 {
+		t.Errorf("got %v, want []", got)
+	}
+}
@@ -0,0 +1,233 @@
+// Package settest implements support for testing implementations of [set.Interface].
+package settest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bobg/go-generics/v4/set"
+)
+
+// TestSet tests a set implementation.
+// The newSet function must produce a new, empty set each time it is called.
+// The vals must be distinct,
+// and there should be at least a handful of them.
+//
+// TestSet checks that the basic operations behave as expected,
+// and that the implementation obeys the laws of set algebra
+// (commutativity, associativity, idempotence, absorption, and De Morgan's laws)
+// when combined with [set.IntersectAny], [set.UnionAny], and [set.DiffAny].
+// It also checks that those functions treat nil as an empty set,
+// both as a nil [set.Interface]
+// and as the zero value of S
+// (a nil pointer, when S is a pointer type).
+// So unless S is an interface type,
+// the zero value of S must behave as an empty set
+// for Has, Len, and All,
+// as the set types in package set do.
+//
+// It returns an error describing every problem found,
+// or nil if there are none.
+//
+// Typical usage inside a test is:
+//
+//	if err := settest.TestSet(newMySet, 1, 2, 3, 4, 5, 6, 7, 8); err != nil {
+//		t.Fatal(err)
+//	}
+func TestSet[T comparable, S set.Interface[T]](newSet func() S, vals ...T) error {
+	if set.New(vals...).Len() != len(vals) {
+		return errors.New("TestSet called with duplicate values")
+	}
+
+	c := &checker[T, S]{newSet: newSet}
+	c.testBasics(vals)
+	c.testNil(vals)
+	c.testLaws(vals)
+	return errors.Join(c.errs...)
+}
+
+type checker[T comparable, S set.Interface[T]] struct {
+	newSet func() S
+	errs   []error
+}
+
+func (c *checker[T, S]) errorf(format string, args ...any) {
+	c.errs = append(c.errs, fmt.Errorf(format, args...))
+}
+
+// build produces a new set containing vals.
+func (c *checker[T, S]) build(vals ...T) S {
+	s := c.newSet()
+	s.Add(vals...)
+	return s
+}
+
+// members produces the members of s as an [set.Of],
+// checking that All produces each one exactly once
+// and agrees with Len and Has.
+func (c *checker[T, S]) members(name string, s S) set.Of[T] {
+	result := set.New[T]()
+	for val := range s.All() {
+		if result.Has(val) {
+			c.errorf("%s: All produced %v more than once", name, val)
+		}
+		result.Add(val)
+		if !s.Has(val) {
+			c.errorf("%s: All produced %v but Has reports it absent", name, val)
+		}
+	}
+	if result.Len() != s.Len() {
+		c.errorf("%s: All produced %d values but Len is %d", name, result.Len(), s.Len())
+	}
+	return result
+}
+
+func (c *checker[T, S]) testBasics(vals []T) {
+	s := c.newSet()
+	if s.Len() != 0 {
+		c.errorf("new set has Len %d, want 0", s.Len())
+	}
+	for _, val := range vals {
+		if s.Has(val) {
+			c.errorf("new set has %v", val)
+		}
+	}
+	c.members("new set", s)
+
+	s.Add(vals...)
+	if s.Len() != len(vals) {
+		c.errorf("after adding %d values, Len is %d", len(vals), s.Len())
+	}
+	for _, val := range vals {
+		if !s.Has(val) {
+			c.errorf("after adding %v, Has reports it absent", val)
+		}
+	}
+	if got := c.members("full set", s); !got.Equal(set.New(vals...)) {
+		c.errorf("full set has members %v, want %v", got, vals)
+	}
+
+	s.Add(vals...)
+	if s.Len() != len(vals) {
+		c.errorf("after adding %d values twice, Len is %d", len(vals), s.Len())
+	}
+
+	half := vals[:len(vals)/2]
+	s.Del(half...)
+	s.Del(half...)
+	if s.Len() != len(vals)-len(half) {
+		c.errorf("after deleting %d of %d values, Len is %d", len(half), len(vals), s.Len())
+	}
+	for i, val := range vals {
+		if want := i >= len(half); s.Has(val) != want {
+			c.errorf("after deleting %v, Has(%v) is %v, want %v", half, val, s.Has(val), want)
+		}
+	}
+	c.members("partial set", s)
+
+	s.Del(vals...)
+	if s.Len() != 0 {
+		c.errorf("after deleting all values, Len is %d", s.Len())
+	}
+}
+
+func (c *checker[T, S]) testNil(vals []T) {
+	var (
+		s    = c.build(vals...)
+		z    S
+		want = set.New(vals...)
+	)
+
+	// When S is itself an interface type,
+	// its zero value is a nil interface with no methods to call.
+	if any(z) != nil {
+		if z.Len() != 0 {
+			c.errorf("zero set has Len %d, want 0", z.Len())
+		}
+		for _, val := range vals {
+			if z.Has(val) {
+				c.errorf("zero set has %v", val)
+			}
+		}
+		for val := range z.All() {
+			c.errorf("zero set produced %v", val)
+		}
+	}
+
+	for _, arg := range []struct {
+		name string
+		nil  set.Interface[T]
+	}{
+		{"nil", nil},
+		{"zero set", z},
+	} {
+		if got := set.UnionAny(s, arg.nil); !got.Equal(want) {
+			c.errorf("union with %s: got %v, want %v", arg.name, got, want)
+		}
+		if got := set.IntersectAny(s, arg.nil); got.Len() != 0 {
+			c.errorf("intersection with %s: got %v, want empty", arg.name, got)
+		}
+		if got := set.DiffAny(s, arg.nil); !got.Equal(want) {
+			c.errorf("difference with %s: got %v, want %v", arg.name, got, want)
+		}
+		if got := set.DiffAny(arg.nil, s); got.Len() != 0 {
+			c.errorf("difference from %s: got %v, want empty", arg.name, got)
+		}
+	}
+}
+
+func (c *checker[T, S]) testLaws(vals []T) {
+	var a, b, d []T
+	for i, val := range vals {
+		if i%2 == 0 {
+			a = append(a, val)
+		}
+		if i%3 == 0 {
+			b = append(b, val)
+		}
+		if i%5 < 2 {
+			d = append(d, val)
+		}
+	}
+	var (
+		u  = c.build(vals...)
+		sa = c.build(a...)
+		sb = c.build(b...)
+		sd = c.build(d...)
+		e  = c.newSet()
+	)
+
+	// wrap converts an Of produced by the set package back to S,
+	// so it can be combined further.
+	wrap := func(s set.Of[T]) S {
+		return c.build(s.Slice()...)
+	}
+
+	type law struct {
+		name     string
+		lhs, rhs set.Of[T]
+	}
+	laws := []law{
+		{"A∪B = B∪A", set.UnionAny[T](sa, sb), set.UnionAny[T](sb, sa)},
+		{"A∩B = B∩A", set.IntersectAny[T](sa, sb), set.IntersectAny[T](sb, sa)},
+		{"(A∪B)∪C = A∪(B∪C)", set.UnionAny[T](wrap(set.UnionAny[T](sa, sb)), sd), set.UnionAny[T](sa, wrap(set.UnionAny[T](sb, sd)))},
+		{"(A∩B)∩C = A∩(B∩C)", set.IntersectAny[T](wrap(set.IntersectAny[T](sa, sb)), sd), set.IntersectAny[T](sa, wrap(set.IntersectAny[T](sb, sd)))},
+		{"A∪A = A", set.UnionAny[T](sa, sa), c.members("A", sa)},
+		{"A∩A = A", set.IntersectAny[T](sa, sa), c.members("A", sa)},
+		{"A∪∅ = A", set.UnionAny[T](sa, e), c.members("A", sa)},
+		{"A∩∅ = ∅", set.IntersectAny[T](sa, e), set.New[T]()},
+		{"A∖∅ = A", set.DiffAny[T](sa, e), c.members("A", sa)},
+		{"A∖A = ∅", set.DiffAny[T](sa, sa), set.New[T]()},
+		{"A∪(A∩B) = A", set.UnionAny[T](sa, wrap(set.IntersectAny[T](sa, sb))), c.members("A", sa)},
+		{"A∩(A∪B) = A", set.IntersectAny[T](sa, wrap(set.UnionAny[T](sa, sb))), c.members("A", sa)},
+		{"A∩(B∪C) = (A∩B)∪(A∩C)", set.IntersectAny[T](sa, wrap(set.UnionAny[T](sb, sd))), set.UnionAny[T](set.IntersectAny[T](sa, sb), set.IntersectAny[T](sa, sd))},
+		{"U∖(A∪B) = (U∖A)∩(U∖B)", set.DiffAny[T](u, wrap(set.UnionAny[T](sa, sb))), set.IntersectAny[T](set.DiffAny[T](u, sa), set.DiffAny[T](u, sb))},
+		{"U∖(A∩B) = (U∖A)∪(U∖B)", set.DiffAny[T](u, wrap(set.IntersectAny[T](sa, sb))), set.UnionAny[T](set.DiffAny[T](u, sa), set.DiffAny[T](u, sb))},
+		{"A∖B = A∩(U∖B)", set.DiffAny[T](sa, sb), set.IntersectAny[T](sa, wrap(set.DiffAny[T](u, sb)))},
+	}
+	for _, l := range laws {
+		if !l.lhs.Equal(l.rhs) {
+			c.errorf("%s does not hold: got %v and %v", l.name, l.lhs, l.rhs)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package settest
+
+import (
+	"iter"
+	"maps"
+	"testing"
+
+	"github.com/bobg/go-generics/v4/set"
+)
+
+func TestImplementations(t *testing.T) {
+	ints := []int{1, 2, 3, 5, 8, 13, 21, 34, 55, 89}
+	strs := []string{"a", "b", "ab", "abc", "ba", "c", "", "cab", "abd"}
+
+	run := func(name string, err error) {
+		t.Run(name, func(t *testing.T) {
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	run("Of", TestSet(func() set.Of[int] { return set.New[int]() }, ints...))
+	run("Sorted", TestSet(func() *set.Sorted[int] { return set.NewSorted[int]() }, ints...))
+	run("Sync", TestSet(func() *set.Sync[int] { return set.NewSync[int]() }, ints...))
+	run("Bits", TestSet(func() *set.Bits[int] { return set.NewBits[int]() }, ints...))
+	run("Ordered", TestSet(func() *set.Ordered[int] { return set.NewOrdered[int]() }, ints...))
+	run("Observable", TestSet(func() *set.Observable[int] { return set.NewObservable[int]() }, ints...))
+	run("Trie", TestSet(func() *set.Trie[string] { return set.NewTrie[string]() }, strs...))
+	run("Keyed", TestSet(func() *set.Keyed[string, string] {
+		return set.NewKeyed(func(s string) string { return s })
+	}, strs...))
+	run("Interface", TestSet(func() set.Interface[int] { return set.NewSorted[int]() }, ints...))
+}
+
+// leaky is a broken set implementation:
+// Del does nothing.
+type leaky map[int]struct{}
+
+func (s leaky) Add(vals ...int) {
+	for _, val := range vals {
+		s[val] = struct{}{}
+	}
+}
+func (leaky) Del(...int) {}
+func (s leaky) Has(val int) bool {
+	_, ok := s[val]
+	return ok
+}
+func (s leaky) Len() int           { return len(s) }
+func (s leaky) All() iter.Seq[int] { return maps.Keys(s) }
+
+func TestBroken(t *testing.T) {
+	err := TestSet(func() leaky { return make(leaky) }, 1, 2, 3, 4, 5, 6)
+	if err == nil {
+		t.Error("got no error for a broken implementation")
+	}
+
+	err = TestSet(func() set.Of[int] { return set.New[int]() }, 1, 1)
+	if err == nil {
+		t.Error("got no error for duplicate values")
+	}
+}
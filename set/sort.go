@@ -0,0 +1,77 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// SortedSlice produces a new slice of the elements in s,
+// in ascending order.
+// The set may be nil.
+func SortedSlice[T cmp.Ordered](s Of[T]) []T {
+	vals := s.Slice()
+	slices.Sort(vals)
+	return vals
+}
+
+// SortedSliceFunc produces a new slice of the elements in s,
+// in the order determined by the comparison function f
+// (which has the same meaning as in [slices.SortFunc]).
+// The set may be nil.
+func SortedSliceFunc[T comparable](s Of[T], f func(a, b T) int) []T {
+	vals := s.Slice()
+	slices.SortFunc(vals, f)
+	return vals
+}
+
+// SortedSeq produces an iterator over the members of s,
+// in ascending order.
+// The set may be nil.
+func SortedSeq[T cmp.Ordered](s Of[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range SortedSlice(s) {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// SortedSeqFunc produces an iterator over the members of s,
+// in the order determined by the comparison function f
+// (which has the same meaning as in [slices.SortFunc]).
+// The set may be nil.
+func SortedSeqFunc[T comparable](s Of[T], f func(a, b T) int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range SortedSliceFunc(s, f) {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// String implements [fmt.Stringer].
+// It formats the set as {a, b, c}.
+// If T is an integer, floating-point, or string type,
+// the members are in ascending order.
+// Otherwise they are in the order of their formatted strings,
+// so the output is deterministic either way.
+// The set may be nil.
+func (s Of[T]) String() string {
+	var strs []string
+	if vals := s.Slice(); sortOrdered(vals) {
+		for _, val := range vals {
+			strs = append(strs, fmt.Sprint(val))
+		}
+	} else {
+		for val := range s {
+			strs = append(strs, fmt.Sprint(val))
+		}
+		slices.Sort(strs)
+	}
+	return "{" + strings.Join(strs, ", ") + "}"
+}
@@ -0,0 +1,53 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestSortedSlice(t *testing.T) {
+	s := New(3, 1, 2)
+	if got := SortedSlice(s); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+	if got := slices.Collect(SortedSeq(s)); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+
+	desc := func(a, b int) int { return cmp.Compare(b, a) }
+	if got := SortedSliceFunc(s, desc); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("got %v, want [3 2 1]", got)
+	}
+	if got := slices.Collect(SortedSeqFunc(s, desc)); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("got %v, want [3 2 1]", got)
+	}
+
+	if got := SortedSlice[int](nil); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestString(t *testing.T) {
+	type point struct{ X, Y int }
+
+	cases := []struct {
+		name string
+		s    fmt.Stringer
+		want string
+	}{
+		{"nil", Of[int](nil), "{}"},
+		{"ints", New(10, 9, -1), "{-1, 9, 10}"},
+		{"strings", New("b", "c", "a"), "{a, b, c}"},
+		{"structs", New(point{2, 1}, point{1, 2}), "{{1 2}, {2 1}}"},
+	}
+	for _, tc := range cases {
+		if got := tc.s.String(); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+		if got := fmt.Sprintf("%v", tc.s); got != tc.want {
+			t.Errorf("%s: got %s with %%v, want %s", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Sorted is a set of ordered elements of type T
+// that keeps its members in ascending order.
+// Unlike [Of],
+// it can iterate over its members in a deterministic order,
+// and it can answer range queries such as [Sorted.Floor] and [Sorted.Ceiling].
+//
+// Membership tests take O(log n) time.
+// Adding and removing members take O(n) time in the worst case.
+// Adding k members in a single call
+// (or with [NewSorted], [CollectSorted], or [Sorted.AddSeq])
+// takes O(n + k log k) time.
+//
+// The zero value of Sorted is an empty set ready to use.
+// A nil *Sorted may be used for read-only operations,
+// where it behaves as an empty set.
+type Sorted[T cmp.Ordered] struct {
+	vals []T
+}
+
+// NewSorted produces a new sorted set containing the given values.
+func NewSorted[T cmp.Ordered](vals ...T) *Sorted[T] {
+	s := new(Sorted[T])
+	s.Add(vals...)
+	return s
+}
+
+// CollectSorted collects the members of the given sequence into a new sorted set.
+func CollectSorted[T cmp.Ordered](inp iter.Seq[T]) *Sorted[T] {
+	vals := slices.Sorted(inp)
+	return &Sorted[T]{vals: slices.Compact(vals)}
+}
+
+// SortedOf produces a new sorted set with the same members as the given set.
+// The input may be nil.
+func SortedOf[T cmp.Ordered](s Of[T]) *Sorted[T] {
+	vals := s.Slice()
+	slices.Sort(vals)
+	return &Sorted[T]{vals: vals}
+}
+
+func (s *Sorted[T]) slice() []T {
+	if s == nil {
+		return nil
+	}
+	return s.vals
+}
+
+// Add adds the given values to the set.
+// Items already present in the set are silently ignored.
+func (s *Sorted[T]) Add(vals ...T) {
+	if len(vals) == 1 {
+		i, found := slices.BinarySearch(s.vals, vals[0])
+		if !found {
+			s.vals = slices.Insert(s.vals, i, vals[0])
+		}
+		return
+	}
+	s.merge(slices.Clone(vals))
+}
+
+// AddSeq adds the members of the given sequence to the set.
+func (s *Sorted[T]) AddSeq(inp iter.Seq[T]) {
+	s.merge(slices.Collect(inp))
+}
+
+// merge adds vals to the set,
+// sorting them and merging them with the existing members in a single pass.
+// It takes ownership of vals.
+func (s *Sorted[T]) merge(vals []T) {
+	if len(vals) == 0 {
+		return
+	}
+	slices.Sort(vals)
+	vals = slices.Compact(vals)
+	if len(s.vals) == 0 {
+		s.vals = vals
+		return
+	}
+	s.vals = mergeSorted(s.vals, vals, true, true, true)
+}
+
+// Has tells whether the given value is in the set.
+// The set may be nil.
+func (s *Sorted[T]) Has(val T) bool {
+	_, found := slices.BinarySearch(s.slice(), val)
+	return found
+}
+
+// Del removes the given items from the set.
+// Items already absent from the set are silently ignored.
+func (s *Sorted[T]) Del(vals ...T) {
+	for _, val := range vals {
+		i, found := slices.BinarySearch(s.vals, val)
+		if found {
+			s.vals = slices.Delete(s.vals, i, i+1)
+		}
+	}
+}
+
+// Len tells the number of distinct values in the set.
+// The set may be nil.
+func (s *Sorted[T]) Len() int {
+	return len(s.slice())
+}
+
+// Equal tests whether the set has the same membership as another.
+// Either set may be nil.
+func (s *Sorted[T]) Equal(other *Sorted[T]) bool {
+	return slices.Equal(s.slice(), other.slice())
+}
+
+// Min produces the least member of the set.
+// The boolean result is false if the set is empty.
+// The set may be nil.
+func (s *Sorted[T]) Min() (T, bool) {
+	vals := s.slice()
+	if len(vals) == 0 {
+		var zero T
+		return zero, false
+	}
+	return vals[0], true
+}
+
+// Max produces the greatest member of the set.
+// The boolean result is false if the set is empty.
+// The set may be nil.
+func (s *Sorted[T]) Max() (T, bool) {
+	vals := s.slice()
+	if len(vals) == 0 {
+		var zero T
+		return zero, false
+	}
+	return vals[len(vals)-1], true
+}
+
+// Floor produces the greatest member of the set that is less than or equal to val.
+// The boolean result is false if there is no such member.
+// The set may be nil.
+func (s *Sorted[T]) Floor(val T) (T, bool) {
+	vals := s.slice()
+	i, found := slices.BinarySearch(vals, val)
+	if found {
+		return vals[i], true
+	}
+	if i == 0 {
+		var zero T
+		return zero, false
+	}
+	return vals[i-1], true
+}
+
+// Ceiling produces the least member of the set that is greater than or equal to val.
+// The boolean result is false if there is no such member.
+// The set may be nil.
+func (s *Sorted[T]) Ceiling(val T) (T, bool) {
+	vals := s.slice()
+	i, _ := slices.BinarySearch(vals, val)
+	if i == len(vals) {
+		var zero T
+		return zero, false
+	}
+	return vals[i], true
+}
+
+// Range produces an iterator over the members of the set
+// that are greater than or equal to lo and less than hi,
+// in ascending order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (s *Sorted[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		vals := s.slice()
+		i, _ := slices.BinarySearch(vals, lo)
+		for ; i < len(vals) && cmp.Less(vals[i], hi); i++ {
+			if !yield(vals[i]) {
+				return
+			}
+		}
+	}
+}
+
+// All produces an iterator over the members of the set,
+// in ascending order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (s *Sorted[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range s.slice() {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// Backward produces an iterator over the members of the set,
+// in descending order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (s *Sorted[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range slices.Backward(s.slice()) {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// Slice produces a new slice of the elements in the set,
+// in ascending order.
+func (s *Sorted[T]) Slice() []T {
+	if s.Len() == 0 {
+		return nil
+	}
+	return slices.Clone(s.slice())
+}
+
+// Set produces a new [Of] with the same members as s.
+// The set may be nil.
+func (s *Sorted[T]) Set() Of[T] {
+	return New(s.slice()...)
+}
+
+// IntersectSorted produces a new sorted set containing only items that appear in all the given sets.
+// The input may include nils,
+// representing empty sets
+// and therefore producing an empty (but non-nil) intersection.
+func IntersectSorted[T cmp.Ordered](sets ...*Sorted[T]) *Sorted[T] {
+	result := new(Sorted[T])
+	if len(sets) == 0 {
+		return result
+	}
+	result.vals = slices.Clone(sets[0].slice())
+	for _, s := range sets[1:] {
+		result.vals = mergeSorted(result.vals, s.slice(), false, true, false)
+	}
+	return result
+}
+
+// UnionSorted produces a new sorted set containing all the items in all the given sets.
+// The input may include nils,
+// representing empty sets.
+// The result is never nil (but may be empty).
+func UnionSorted[T cmp.Ordered](sets ...*Sorted[T]) *Sorted[T] {
+	result := new(Sorted[T])
+	for _, s := range sets {
+		result.vals = mergeSorted(result.vals, s.slice(), true, true, true)
+	}
+	return result
+}
+
+// DiffSorted produces a new sorted set containing the items in s1 that are not also in s2.
+// Either set may be nil.
+// The result is never nil (but may be empty).
+func DiffSorted[T cmp.Ordered](s1, s2 *Sorted[T]) *Sorted[T] {
+	return &Sorted[T]{vals: mergeSorted(s1.slice(), s2.slice(), true, false, false)}
+}
+
+// mergeSorted walks two ascending slices in step,
+// producing a new ascending slice.
+// The booleans say whether to keep values found only in a,
+// values found in both,
+// and values found only in b.
+func mergeSorted[T cmp.Ordered](a, b []T, onlyA, both, onlyB bool) []T {
+	var result []T
+	for len(a) > 0 && len(b) > 0 {
+		switch c := cmp.Compare(a[0], b[0]); {
+		case c < 0:
+			if onlyA {
+				result = append(result, a[0])
+			}
+			a = a[1:]
+		case c > 0:
+			if onlyB {
+				result = append(result, b[0])
+			}
+			b = b[1:]
+		default:
+			if both {
+				result = append(result, a[0])
+			}
+			a, b = a[1:], b[1:]
+		}
+	}
+	if onlyA {
+		result = append(result, a...)
+	}
+	if onlyB {
+		result = append(result, b...)
+	}
+	return result
+}
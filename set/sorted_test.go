@@ -0,0 +1,141 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSorted(t *testing.T) {
+	s := NewSorted(5, 1, 9, 3, 7, 3)
+	if s.Len() != 5 {
+		t.Errorf("got len %d, want 5", s.Len())
+	}
+	if got := s.Slice(); !slices.Equal(got, []int{1, 3, 5, 7, 9}) {
+		t.Errorf("got %v, want [1 3 5 7 9]", got)
+	}
+	if !s.Has(7) {
+		t.Error("set should contain 7")
+	}
+	if s.Has(4) {
+		t.Error("set should not contain 4")
+	}
+
+	s.Add(4)
+	s.Del(9, 100)
+	if got := slices.Collect(s.All()); !slices.Equal(got, []int{1, 3, 4, 5, 7}) {
+		t.Errorf("got %v, want [1 3 4 5 7]", got)
+	}
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, []int{7, 5, 4, 3, 1}) {
+		t.Errorf("got %v, want [7 5 4 3 1]", got)
+	}
+	if got := slices.Collect(s.Range(3, 7)); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("got %v, want [3 4 5]", got)
+	}
+
+	if got, ok := s.Min(); !ok || got != 1 {
+		t.Errorf("got min %d (%v), want 1", got, ok)
+	}
+	if got, ok := s.Max(); !ok || got != 7 {
+		t.Errorf("got max %d (%v), want 7", got, ok)
+	}
+
+	floors := []struct {
+		val, want int
+		ok        bool
+	}{
+		{val: 0, ok: false},
+		{val: 1, want: 1, ok: true},
+		{val: 2, want: 1, ok: true},
+		{val: 6, want: 5, ok: true},
+		{val: 100, want: 7, ok: true},
+	}
+	for _, tc := range floors {
+		got, ok := s.Floor(tc.val)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("Floor(%d): got %d (%v), want %d (%v)", tc.val, got, ok, tc.want, tc.ok)
+		}
+	}
+
+	ceilings := []struct {
+		val, want int
+		ok        bool
+	}{
+		{val: 0, want: 1, ok: true},
+		{val: 2, want: 3, ok: true},
+		{val: 7, want: 7, ok: true},
+		{val: 8, ok: false},
+	}
+	for _, tc := range ceilings {
+		got, ok := s.Ceiling(tc.val)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("Ceiling(%d): got %d (%v), want %d (%v)", tc.val, got, ok, tc.want, tc.ok)
+		}
+	}
+
+	var empty *Sorted[int]
+	if empty.Len() != 0 || empty.Has(1) {
+		t.Error("nil set should be empty")
+	}
+	if _, ok := empty.Min(); ok {
+		t.Error("nil set should have no min")
+	}
+}
+
+func TestSortedConversion(t *testing.T) {
+	var (
+		of = New(3, 1, 2)
+		s  = SortedOf(of)
+	)
+	if got := s.Slice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+	if !s.Set().Equal(of) {
+		t.Errorf("got %v, want %v", s.Set(), of)
+	}
+}
+
+func TestSortedAlgebra(t *testing.T) {
+	var (
+		s1 = NewSorted(1, 2, 3, 4, 5)
+		s2 = NewSorted(4, 5, 6, 7, 8)
+		s3 = NewSorted(5, 8)
+	)
+
+	if got := IntersectSorted(s1, s2, s3).Slice(); !slices.Equal(got, []int{5}) {
+		t.Errorf("got %v, want [5]", got)
+	}
+	if got := IntersectSorted(s1, nil); got == nil || got.Len() != 0 {
+		t.Errorf("got %v, want empty", got)
+	}
+	if got := UnionSorted(s1, s2, nil).Slice(); !slices.Equal(got, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("got %v, want [1 2 3 4 5 6 7 8]", got)
+	}
+	if got := DiffSorted(s1, s2).Slice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+	if got := DiffSorted(nil, s2); got == nil || got.Len() != 0 {
+		t.Errorf("got %v, want empty", got)
+	}
+}
+
+func TestSortedBulk(t *testing.T) {
+	s := NewSorted(10, 20, 30)
+	vals := []int{25, 5, 20, 35, 5, 15}
+	s.Add(vals...)
+	if got := s.Slice(); !slices.Equal(got, []int{5, 10, 15, 20, 25, 30, 35}) {
+		t.Errorf("got %v, want [5 10 15 20 25 30 35]", got)
+	}
+	if !slices.Equal(vals, []int{25, 5, 20, 35, 5, 15}) {
+		t.Errorf("Add reordered its argument: %v", vals)
+	}
+
+	s.AddSeq(slices.Values([]int{40, 0, 40}))
+	if got := s.Slice(); !slices.Equal(got, []int{0, 5, 10, 15, 20, 25, 30, 35, 40}) {
+		t.Errorf("got %v, want [0 5 10 15 20 25 30 35 40]", got)
+	}
+
+	c := CollectSorted(slices.Values([]int{3, 1, 2, 3, 1}))
+	if got := c.Slice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+}
@@ -0,0 +1,265 @@
+package set
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Integers is a set of integers
+// that implements [driver.Valuer] and [sql.Scanner],
+// for storing in a Postgres array column.
+// It has the same underlying type as [Of],
+// so it is cheap to convert between the two:
+// use Integers[T](s) to store an Of[T],
+// and Of[T](i) to get the methods of Of back.
+//
+// See [Strings] for sets of strings.
+// (Of itself does not implement those interfaces,
+// since database drivers and ORMs use them when present,
+// and most sets cannot be stored as arrays.)
+type Integers[T Integer] Of[T]
+
+// Value implements [driver.Valuer].
+// The set is encoded as a Postgres array literal,
+// such as {1,2,3},
+// in sorted order.
+// A nil set is encoded as NULL.
+func (s Integers[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	signed := isSigned[T]()
+	return pgArray(SortedSlice(Of[T](s)), func(buf *strings.Builder, val T) {
+		if signed {
+			buf.WriteString(strconv.FormatInt(int64(val), 10))
+		} else {
+			buf.WriteString(strconv.FormatUint(uint64(val), 10))
+		}
+	}), nil
+}
+
+// Scan implements [sql.Scanner].
+// It decodes a one-dimensional Postgres array literal,
+// such as {1,2,3},
+// replacing the contents of the set.
+// Duplicate elements are silently ignored.
+// A SQL NULL produces a nil set.
+// NULL elements within the array are an error,
+// as are elements out of range for T.
+func (s *Integers[T]) Scan(src any) error {
+	signed := isSigned[T]()
+	return scanPGArray((*Of[T])(s), src, func(str string) (T, error) {
+		if signed {
+			n, err := strconv.ParseInt(str, 10, 64)
+			if err == nil && int64(T(n)) != n {
+				err = strconv.ErrRange
+			}
+			return T(n), err
+		}
+		n, err := strconv.ParseUint(str, 10, 64)
+		if err == nil && uint64(T(n)) != n {
+			err = strconv.ErrRange
+		}
+		return T(n), err
+	})
+}
+
+// isSigned tells whether T is a signed integer type.
+func isSigned[T Integer]() bool {
+	var zero T
+	return zero-1 < 0
+}
+
+// Value implements [driver.Valuer].
+// The set is encoded as a Postgres array literal,
+// such as {a,b,"c d"},
+// in sorted order.
+// A nil set is encoded as NULL.
+func (s Strings[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return pgArray(SortedSlice(Of[T](s)), func(buf *strings.Builder, val T) {
+		writePGArrayString(buf, string(val))
+	}), nil
+}
+
+// Scan implements [sql.Scanner].
+// It decodes a one-dimensional Postgres array literal,
+// such as {a,b,"c d"},
+// replacing the contents of the set.
+// Duplicate elements are silently ignored.
+// A SQL NULL produces a nil set.
+// NULL elements within the array are an error.
+func (s *Strings[T]) Scan(src any) error {
+	return scanPGArray((*Of[T])(s), src, func(str string) (T, error) {
+		return T(str), nil
+	})
+}
+
+// pgArray encodes vals as a Postgres array literal,
+// using write to encode each element.
+func pgArray[T any](vals []T, write func(*strings.Builder, T)) string {
+	buf := new(strings.Builder)
+	buf.WriteByte('{')
+	for i, val := range vals {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		write(buf, val)
+	}
+	buf.WriteByte('}')
+	return buf.String()
+}
+
+// scanPGArray decodes src,
+// a Postgres array literal as a string or []byte,
+// into *s,
+// using parse to decode each element.
+func scanPGArray[T comparable](s *Of[T], src any, parse func(string) (T, error)) error {
+	var text string
+	switch src := src.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case string:
+		text = src
+	case []byte:
+		text = string(src)
+	default:
+		return fmt.Errorf("cannot scan %T into set of %T", src, *new(T))
+	}
+
+	strs, err := parsePGArray(text)
+	if err != nil {
+		return err
+	}
+
+	result := New[T]()
+	for _, str := range strs {
+		val, err := parse(str)
+		if err != nil {
+			return fmt.Errorf("parsing array element %q: %w", str, err)
+		}
+		result.Add(val)
+	}
+	*s = result
+	return nil
+}
+
+// writePGArrayString writes str as an element of a Postgres array literal,
+// quoting it if necessary.
+func writePGArrayString(buf *strings.Builder, str string) {
+	needsQuotes := str == "" || strings.EqualFold(str, "NULL") || strings.ContainsAny(str, "{}\",\\ \t\n\r\v\f")
+	if !needsQuotes {
+		buf.WriteString(str)
+		return
+	}
+	buf.WriteByte('"')
+	for _, r := range str {
+		if r == '"' || r == '\\' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteRune(r)
+	}
+	buf.WriteByte('"')
+}
+
+// parsePGArray parses a one-dimensional Postgres array literal
+// into its elements.
+func parsePGArray(text string) ([]string, error) {
+	text = strings.TrimSpace(text)
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, fmt.Errorf("malformed array literal %q", text)
+	}
+	body := text[1 : len(text)-1]
+	if strings.TrimSpace(body) == "" {
+		return nil, nil
+	}
+
+	var (
+		result []string
+		i      int
+	)
+	for {
+		for i < len(body) && isPGSpace(body[i]) {
+			i++
+		}
+		if i == len(body) {
+			return nil, fmt.Errorf("malformed array literal %q: missing element", text)
+		}
+
+		var elem strings.Builder
+		switch body[i] {
+		case '"':
+			i++
+			for {
+				if i == len(body) {
+					return nil, fmt.Errorf("malformed array literal %q: unterminated quoted element", text)
+				}
+				c := body[i]
+				i++
+				if c == '"' {
+					break
+				}
+				if c == '\\' {
+					if i == len(body) {
+						return nil, fmt.Errorf("malformed array literal %q: unterminated quoted element", text)
+					}
+					c = body[i]
+					i++
+				}
+				elem.WriteByte(c)
+			}
+			result = append(result, elem.String())
+
+		case '{':
+			return nil, fmt.Errorf("multidimensional array literal %q not supported", text)
+
+		default:
+			var escaped bool
+			for i < len(body) && body[i] != ',' {
+				c := body[i]
+				if c == '"' || c == '{' || c == '}' {
+					return nil, fmt.Errorf("malformed array literal %q: unexpected %q", text, c)
+				}
+				if c == '\\' {
+					i++
+					if i == len(body) {
+						return nil, fmt.Errorf("malformed array literal %q: trailing backslash", text)
+					}
+					c = body[i]
+					escaped = true
+				}
+				elem.WriteByte(c)
+				i++
+			}
+			str := strings.TrimRight(elem.String(), " \t\n\r\v\f")
+			if !escaped && strings.EqualFold(str, "NULL") {
+				return nil, fmt.Errorf("array literal %q contains NULL element", text)
+			}
+			result = append(result, str)
+		}
+
+		for i < len(body) && isPGSpace(body[i]) {
+			i++
+		}
+		if i == len(body) {
+			return result, nil
+		}
+		if body[i] != ',' {
+			return nil, fmt.Errorf("malformed array literal %q: unexpected %q", text, body[i])
+		}
+		i++
+	}
+}
+
+func isPGSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+	return false
+}
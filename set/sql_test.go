@@ -0,0 +1,215 @@
+package set
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestSQLValue(t *testing.T) {
+	cases := []struct {
+		name string
+		val  driver.Valuer
+		want driver.Value
+	}{
+		{"nil", Strings[string](nil), nil},
+		{"empty", Strings[string](New[string]()), "{}"},
+		{"strings", Strings[string](New("b", "a")), "{a,b}"},
+		{"quoted", Strings[string](New("c d", "", "null", `x"y`, `p\q`, "{}")), `{"","c d","null","p\\q","x\"y","{}"}`},
+		{"ints", Integers[int](New(10, -3, 2)), "{-3,2,10}"},
+		{"int64s", Integers[int64](New[int64](math.MinInt64, math.MaxInt64)), "{-9223372036854775808,9223372036854775807}"},
+		{"uints", Integers[uint8](New[uint8](255, 0)), "{0,255}"},
+		{"uint64s", Integers[uint64](New[uint64](math.MaxUint64)), "{18446744073709551615}"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.val.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	// Sets of other element types are not SQL values.
+	if _, ok := any(New(1.5)).(driver.Valuer); ok {
+		t.Error("Of[float64] should not implement driver.Valuer")
+	}
+	if _, ok := any(new(Of[string])).(sql.Scanner); ok {
+		t.Error("*Of[string] should not implement sql.Scanner")
+	}
+}
+
+func TestSQLScan(t *testing.T) {
+	cases := []struct {
+		name    string
+		src     any
+		want    Strings[string]
+		wantErr bool
+	}{
+		{name: "null", src: nil, want: nil},
+		{name: "empty", src: "{}", want: Strings[string](New[string]())},
+		{name: "simple", src: []byte("{a,b,a}"), want: Strings[string](New("a", "b"))},
+		{name: "spaces", src: "{ a , b c ,d }", want: Strings[string](New("a", "b c", "d"))},
+		{name: "quoted", src: `{"","c d","NULL","x\"y","p\\q"}`, want: Strings[string](New("", "c d", "NULL", `x"y`, `p\q`))},
+		{name: "escaped_null", src: `{\NULL}`, want: Strings[string](New("NULL"))},
+		{name: "null_element", src: "{a,NULL}", wantErr: true},
+		{name: "multidim", src: "{{a},{b}}", wantErr: true},
+		{name: "unterminated", src: `{"a}`, wantErr: true},
+		{name: "missing_element", src: "{a,}", wantErr: true},
+		{name: "not_array", src: "a,b", wantErr: true},
+		{name: "wrong_type", src: 7, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Strings[string](New("stale"))
+			err := got.Scan(tc.src)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("got %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (got == nil) != (tc.want == nil) || !Of[string](got).Equal(Of[string](tc.want)) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	var ints Integers[int16]
+	if err := ints.Scan("{3,-4}"); err != nil {
+		t.Fatal(err)
+	}
+	if !Of[int16](ints).Equal(New[int16](3, -4)) {
+		t.Errorf("got %v, want [-4 3]", ints)
+	}
+	if err := ints.Scan("{40000}"); err == nil {
+		t.Error("got no error for out-of-range element")
+	}
+
+	var uints Integers[uint8]
+	if err := uints.Scan("{0,255}"); err != nil {
+		t.Fatal(err)
+	}
+	if err := uints.Scan("{-1}"); err == nil {
+		t.Error("got no error for negative unsigned element")
+	}
+	if err := uints.Scan("{256}"); err == nil {
+		t.Error("got no error for out-of-range unsigned element")
+	}
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	// Using a connector rather than sql.Register
+	// keeps the fake out of the global driver registry,
+	// so the test can run more than once per process.
+	db := sql.OpenDB(&fakeDriver{})
+	defer db.Close()
+
+	want := Strings[string](New("x", "y z", `"q"`))
+	if _, err := db.Exec("INSERT", want); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT", Strings[string](nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var got []Strings[string]
+	for rows.Next() {
+		var s Strings[string]
+		if err := rows.Scan(&s); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, s)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d rows, want 2", len(got))
+	}
+	if !Of[string](got[0]).Equal(Of[string](want)) {
+		t.Errorf("got %v, want %v", got[0], want)
+	}
+	if got[1] != nil {
+		t.Errorf("got %v, want nil", got[1])
+	}
+}
+
+// fakeDriver is a database/sql driver and connector
+// for a single one-column table.
+// The query "INSERT" adds a row holding its argument,
+// and the query "SELECT" produces all rows.
+type fakeDriver struct {
+	rows []driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error)             { return fakeConn{d: d}, nil }
+func (d *fakeDriver) Connect(context.Context) (driver.Conn, error) { return d.Open("") }
+func (d *fakeDriver) Driver() driver.Driver                        { return d }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{d: c.d, query: query}, nil
+}
+func (fakeConn) Close() error              { return nil }
+func (fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+func (fakeStmt) Close() error { return nil }
+
+func (s fakeStmt) NumInput() int {
+	if s.query == "INSERT" {
+		return 1
+	}
+	return 0
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query != "INSERT" {
+		return nil, errors.New("unknown query")
+	}
+	s.d.rows = append(s.d.rows, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	if s.query != "SELECT" {
+		return nil, errors.New("unknown query")
+	}
+	return &fakeRows{vals: s.d.rows}, nil
+}
+
+type fakeRows struct{ vals []driver.Value }
+
+func (*fakeRows) Columns() []string { return []string{"tags"} }
+func (*fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.vals) == 0 {
+		return io.EOF
+	}
+	dest[0], r.vals = r.vals[0], r.vals[1:]
+	return nil
+}
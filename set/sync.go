@@ -0,0 +1,162 @@
+package set
+
+import (
+	"iter"
+	"sync"
+)
+
+// Sync is a set of elements of type T
+// that is safe for concurrent use by multiple goroutines.
+//
+// The zero value of Sync is an empty set ready to use.
+// A nil *Sync may be used for read-only operations,
+// where it behaves as an empty set.
+// A Sync must not be copied after first use.
+type Sync[T comparable] struct {
+	mu sync.RWMutex
+	s  Of[T]
+}
+
+// NewSync produces a new concurrency-safe set containing the given values.
+func NewSync[T comparable](vals ...T) *Sync[T] {
+	return &Sync[T]{s: New(vals...)}
+}
+
+// Add adds the given values to the set.
+// Items already present in the set are silently ignored.
+func (s *Sync[T]) Add(vals ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.s == nil {
+		s.s = New[T]()
+	}
+	s.s.Add(vals...)
+}
+
+// AddSeq adds the members of the given sequence to the set.
+// The lock is not held while the sequence is being consumed,
+// so other goroutines may observe a partial result.
+func (s *Sync[T]) AddSeq(inp iter.Seq[T]) {
+	for val := range inp {
+		s.Add(val)
+	}
+}
+
+// AddIfAbsent adds val to the set if it is not already present.
+// It tells whether the set changed.
+func (s *Sync[T]) AddIfAbsent(val T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.s.Has(val) {
+		return false
+	}
+	if s.s == nil {
+		s.s = New[T]()
+	}
+	s.s.Add(val)
+	return true
+}
+
+// Has tells whether the given value is in the set.
+// The set may be nil.
+func (s *Sync[T]) Has(val T) bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.s.Has(val)
+}
+
+// Del removes the given items from the set.
+// Items already absent from the set are silently ignored.
+func (s *Sync[T]) Del(vals ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.s.Del(vals...)
+}
+
+// DelIfPresent removes val from the set if it is present.
+// It tells whether the set changed.
+func (s *Sync[T]) DelIfPresent(val T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.s.Has(val) {
+		return false
+	}
+	s.s.Del(val)
+	return true
+}
+
+// Len tells the number of distinct values in the set.
+// The set may be nil.
+func (s *Sync[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.s.Len()
+}
+
+// Snapshot produces a new [Of] containing the current members of the set.
+// Later changes to s do not affect the snapshot, and vice versa.
+// The set may be nil.
+// The result is never nil (but may be empty).
+func (s *Sync[T]) Snapshot() Of[T] {
+	if s == nil {
+		return New[T]()
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make(Of[T], len(s.s))
+	for val := range s.s {
+		result[val] = struct{}{}
+	}
+	return result
+}
+
+// Each calls a function on each element of the set in an indeterminate order.
+// It operates on a snapshot of the set,
+// so the function may safely add and remove items
+// (and so may other goroutines)
+// without affecting the sequence of values seen during the same Each call.
+func (s *Sync[T]) Each(f func(T)) {
+	s.Snapshot().Each(f)
+}
+
+// Eachx calls a function on each element of the set in an indeterminate order.
+// It operates on a snapshot of the set,
+// so the function may safely add and remove items
+// (and so may other goroutines)
+// without affecting the sequence of values seen during the same Eachx call.
+// If the function returns an error,
+// Eachx stops and returns that error.
+func (s *Sync[T]) Eachx(f func(T) error) error {
+	return s.Snapshot().Eachx(f)
+}
+
+// All produces an iterator over the members of the set,
+// in an indeterminate order.
+// The iterator operates on a snapshot of the set taken when iteration begins,
+// so it is safe to use while other goroutines change the set.
+// The set may be nil.
+func (s *Sync[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for val := range s.Snapshot() {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package set
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSync(t *testing.T) {
+	var s Sync[int]
+	s.Add(1, 2, 3)
+	if !s.Has(2) {
+		t.Error("set should contain 2")
+	}
+	if s.Len() != 3 {
+		t.Errorf("got len %d, want 3", s.Len())
+	}
+	if s.AddIfAbsent(2) {
+		t.Error("AddIfAbsent(2) reported a change")
+	}
+	if !s.AddIfAbsent(4) {
+		t.Error("AddIfAbsent(4) reported no change")
+	}
+	if !s.DelIfPresent(1) {
+		t.Error("DelIfPresent(1) reported no change")
+	}
+	if s.DelIfPresent(1) {
+		t.Error("DelIfPresent(1) reported a change")
+	}
+
+	snap := s.Snapshot()
+	if !snap.Equal(New(2, 3, 4)) {
+		t.Errorf("got %v, want [2 3 4]", snap)
+	}
+	s.Add(5)
+	if snap.Has(5) {
+		t.Error("snapshot should not see later changes")
+	}
+
+	got := New[int]()
+	for val := range s.All() {
+		got.Add(val)
+		s.Del(val) // must not deadlock
+	}
+	if !got.Equal(New(2, 3, 4, 5)) {
+		t.Errorf("got %v, want [2 3 4 5]", got)
+	}
+	if s.Len() != 0 {
+		t.Errorf("got len %d, want 0", s.Len())
+	}
+}
+
+func TestSyncConcurrent(t *testing.T) {
+	var (
+		s     = NewSync[int]()
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		added int
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if s.AddIfAbsent(j) {
+					mu.Lock()
+					added++
+					mu.Unlock()
+				}
+				for range s.All() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if added != 100 {
+		t.Errorf("got %d successful adds, want 100", added)
+	}
+	if s.Len() != 100 {
+		t.Errorf("got len %d, want 100", s.Len())
+	}
+}
@@ -0,0 +1,128 @@
+package set
+
+// Map runs a simple function on each member of a set,
+// collecting the results in a new set.
+// Distinct members may map to the same result,
+// so the new set may be smaller than the original.
+// The set may be nil.
+func Map[T, U comparable](s Of[T], f func(T) U) Of[U] {
+	result, _ := Mapx(s, func(val T) (U, error) {
+		return f(val), nil
+	})
+	return result
+}
+
+// Mapx is the extended form of [Map].
+// It runs a function on each member of a set,
+// collecting the results in a new set.
+// If any call to the function returns an error,
+// Mapx stops looping and exits with the error.
+func Mapx[T, U comparable](s Of[T], f func(T) (U, error)) (Of[U], error) {
+	result := make(Of[U], len(s))
+	for val := range s {
+		u, err := f(val)
+		if err != nil {
+			return nil, err
+		}
+		result.Add(u)
+	}
+	return result, nil
+}
+
+// Filter calls a simple predicate for each member of a set,
+// returning a new set of those members for which the predicate returned true.
+// The set may be nil.
+func Filter[T comparable](s Of[T], f func(T) bool) Of[T] {
+	result, _ := Filterx(s, func(val T) (bool, error) {
+		return f(val), nil
+	})
+	return result
+}
+
+// Filterx is the extended form of [Filter].
+// It calls a predicate for each member of a set,
+// returning a new set of those members for which the predicate returned true.
+// If any call to the predicate returns an error,
+// Filterx stops looping and exits with the error.
+func Filterx[T comparable](s Of[T], f func(T) (bool, error)) (Of[T], error) {
+	result := New[T]()
+	for val := range s {
+		ok, err := f(val)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result.Add(val)
+		}
+	}
+	return result, nil
+}
+
+// Partition calls a simple predicate for each member of a set,
+// returning a new set of those members for which the predicate returned true
+// and another of those for which it returned false.
+// The set may be nil.
+func Partition[T comparable](s Of[T], f func(T) bool) (in, out Of[T]) {
+	in, out, _ = Partitionx(s, func(val T) (bool, error) {
+		return f(val), nil
+	})
+	return in, out
+}
+
+// Partitionx is the extended form of [Partition].
+// It calls a predicate for each member of a set,
+// returning a new set of those members for which the predicate returned true
+// and another of those for which it returned false.
+// If any call to the predicate returns an error,
+// Partitionx stops looping and exits with the error.
+func Partitionx[T comparable](s Of[T], f func(T) (bool, error)) (in, out Of[T], err error) {
+	in, out = New[T](), New[T]()
+	for val := range s {
+		ok, err := f(val)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			in.Add(val)
+		} else {
+			out.Add(val)
+		}
+	}
+	return in, out, nil
+}
+
+// GroupBy partitions the members of a set into groups.
+// It does this by calling a simple grouping function on each member,
+// which produces a grouping key.
+// The result is a map of group keys to sets of members having that key.
+// The set may be nil.
+func GroupBy[T, K comparable](s Of[T], f func(T) K) map[K]Of[T] {
+	result, _ := GroupByx(s, func(val T) (K, error) {
+		return f(val), nil
+	})
+	return result
+}
+
+// GroupByx is the extended form of [GroupBy].
+// It partitions the members of a set into groups.
+// It does this by calling a grouping function on each member,
+// which produces a grouping key.
+// The result is a map of group keys to sets of members having that key.
+// If any call to the grouping function returns an error,
+// GroupByx stops looping and exits with the error.
+func GroupByx[T, K comparable](s Of[T], f func(T) (K, error)) (map[K]Of[T], error) {
+	result := make(map[K]Of[T])
+	for val := range s {
+		key, err := f(val)
+		if err != nil {
+			return nil, err
+		}
+		group, ok := result[key]
+		if !ok {
+			group = New[T]()
+			result[key] = group
+		}
+		group.Add(val)
+	}
+	return result, nil
+}
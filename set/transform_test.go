@@ -0,0 +1,71 @@
+package set
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	got := Map(New(-2, -1, 0, 1, 2), func(x int) int { return x * x })
+	if !got.Equal(New(0, 1, 4)) {
+		t.Errorf("got %v, want [0 1 4]", got)
+	}
+
+	errBad := errors.New("bad")
+	_, err := Mapx(New(1, 2, 3), func(x int) (string, error) {
+		if x == 2 {
+			return "", errBad
+		}
+		return "ok", nil
+	})
+	if !errors.Is(err, errBad) {
+		t.Errorf("got error %v, want %v", err, errBad)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	even := func(x int) bool { return x%2 == 0 }
+
+	got := Filter(New(1, 2, 3, 4, 5, 6), even)
+	if !got.Equal(New(2, 4, 6)) {
+		t.Errorf("got %v, want [2 4 6]", got)
+	}
+	if got := Filter(nil, even); got == nil || got.Len() != 0 {
+		t.Errorf("got %v, want empty", got)
+	}
+
+	errBad := errors.New("bad")
+	_, err := Filterx(New(1, 2), func(int) (bool, error) { return false, errBad })
+	if !errors.Is(err, errBad) {
+		t.Errorf("got error %v, want %v", err, errBad)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	in, out := Partition(New(1, 2, 3, 4, 5), func(x int) bool { return x > 3 })
+	if !in.Equal(New(4, 5)) || !out.Equal(New(1, 2, 3)) {
+		t.Errorf("got %v and %v, want [4 5] and [1 2 3]", in, out)
+	}
+
+	errBad := errors.New("bad")
+	_, _, err := Partitionx(New(1), func(int) (bool, error) { return false, errBad })
+	if !errors.Is(err, errBad) {
+		t.Errorf("got error %v, want %v", err, errBad)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	got := GroupBy(New("apple", "avocado", "banana", "cherry", "blueberry"), func(s string) byte { return s[0] })
+	if len(got) != 3 {
+		t.Errorf("got %d groups, want 3", len(got))
+	}
+	if !got['a'].Equal(New("apple", "avocado")) || !got['b'].Equal(New("banana", "blueberry")) || !got['c'].Equal(New("cherry")) {
+		t.Errorf("got %v", got)
+	}
+
+	errBad := errors.New("bad")
+	_, err := GroupByx(New(1), func(int) (int, error) { return 0, errBad })
+	if !errors.Is(err, errBad) {
+		t.Errorf("got error %v, want %v", err, errBad)
+	}
+}
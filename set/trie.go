@@ -0,0 +1,260 @@
+package set
+
+import (
+	"iter"
+	"sort"
+)
+
+// Trie is a set of strings (or values of some other string type T)
+// that supports prefix queries:
+// finding all members with a given prefix,
+// and finding the longest member that is a prefix of a given string.
+// It iterates over its members in lexicographic (bytewise) order.
+//
+// The zero value of Trie is an empty set ready to use.
+// A nil *Trie may be used for read-only operations,
+// where it behaves as an empty set.
+type Trie[T ~string] struct {
+	root trieNode
+	n    int
+}
+
+type trieNode struct {
+	member bool
+	edges  []trieEdge // sorted by b
+}
+
+type trieEdge struct {
+	b     byte
+	child *trieNode
+}
+
+// NewTrie produces a new trie containing the given values.
+func NewTrie[T ~string](vals ...T) *Trie[T] {
+	t := new(Trie[T])
+	t.Add(vals...)
+	return t
+}
+
+// CollectTrie collects the members of the given sequence into a new trie.
+func CollectTrie[T ~string](inp iter.Seq[T]) *Trie[T] {
+	t := new(Trie[T])
+	t.AddSeq(inp)
+	return t
+}
+
+// TrieOf produces a new trie with the same members as the given set.
+// The input may be nil.
+func TrieOf[T ~string](s Of[T]) *Trie[T] {
+	return CollectTrie(s.All())
+}
+
+// find locates the child of node for byte b.
+// It returns the position where the edge is or would be,
+// and whether it is present.
+func (node *trieNode) find(b byte) (int, bool) {
+	i := sort.Search(len(node.edges), func(i int) bool { return node.edges[i].b >= b })
+	return i, i < len(node.edges) && node.edges[i].b == b
+}
+
+// lookup finds the node for the given string,
+// or nil if there is none.
+func (t *Trie[T]) lookup(s T) *trieNode {
+	if t == nil {
+		return nil
+	}
+	node := &t.root
+	for i := 0; i < len(s); i++ {
+		pos, ok := node.find(s[i])
+		if !ok {
+			return nil
+		}
+		node = node.edges[pos].child
+	}
+	return node
+}
+
+// Add adds the given values to the set.
+// Items already present in the set are silently ignored.
+func (t *Trie[T]) Add(vals ...T) {
+	for _, val := range vals {
+		node := &t.root
+		for i := 0; i < len(val); i++ {
+			pos, ok := node.find(val[i])
+			if !ok {
+				node.edges = append(node.edges, trieEdge{})
+				copy(node.edges[pos+1:], node.edges[pos:])
+				node.edges[pos] = trieEdge{b: val[i], child: new(trieNode)}
+			}
+			node = node.edges[pos].child
+		}
+		if !node.member {
+			node.member = true
+			t.n++
+		}
+	}
+}
+
+// AddSeq adds the members of the given sequence to the set.
+func (t *Trie[T]) AddSeq(inp iter.Seq[T]) {
+	for val := range inp {
+		t.Add(val)
+	}
+}
+
+// Has tells whether the given value is in the set.
+// The set may be nil.
+func (t *Trie[T]) Has(val T) bool {
+	node := t.lookup(val)
+	return node != nil && node.member
+}
+
+// Del removes the given items from the set.
+// Items already absent from the set are silently ignored.
+func (t *Trie[T]) Del(vals ...T) {
+	for _, val := range vals {
+		if t.root.del(string(val)) {
+			t.n--
+		}
+	}
+}
+
+// del removes s from the subtree rooted at node,
+// pruning nodes that no longer lead to any member.
+// It tells whether s was present.
+func (node *trieNode) del(s string) bool {
+	if s == "" {
+		if !node.member {
+			return false
+		}
+		node.member = false
+		return true
+	}
+	pos, ok := node.find(s[0])
+	if !ok {
+		return false
+	}
+	child := node.edges[pos].child
+	if !child.del(s[1:]) {
+		return false
+	}
+	if !child.member && len(child.edges) == 0 {
+		node.edges = append(node.edges[:pos], node.edges[pos+1:]...)
+	}
+	return true
+}
+
+// Len tells the number of distinct values in the set.
+// The set may be nil.
+func (t *Trie[T]) Len() int {
+	if t == nil {
+		return 0
+	}
+	return t.n
+}
+
+// Equal tests whether the set has the same membership as another.
+// Either set may be nil.
+func (t *Trie[T]) Equal(other *Trie[T]) bool {
+	if t.Len() != other.Len() {
+		return false
+	}
+	for val := range t.All() {
+		if !other.Has(val) {
+			return false
+		}
+	}
+	return true
+}
+
+// All produces an iterator over the members of the set,
+// in lexicographic order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (t *Trie[T]) All() iter.Seq[T] {
+	return t.WithPrefix("")
+}
+
+// WithPrefix produces an iterator over the members of the set
+// that begin with the given prefix,
+// in lexicographic order.
+// The set may be nil.
+// It should not be modified during iteration.
+func (t *Trie[T]) WithPrefix(prefix T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		node := t.lookup(prefix)
+		if node == nil {
+			return
+		}
+		buf := []byte(prefix)
+		node.each(&buf, func(s []byte) bool {
+			return yield(T(s))
+		})
+	}
+}
+
+// each calls yield with each member in the subtree rooted at node,
+// in lexicographic order.
+// The buffer holds the path to node;
+// it is restored to that state on return.
+// The result is false if yield returned false.
+func (node *trieNode) each(buf *[]byte, yield func([]byte) bool) bool {
+	if node.member && !yield(*buf) {
+		return false
+	}
+	for _, e := range node.edges {
+		*buf = append(*buf, e.b)
+		ok := e.child.each(buf, yield)
+		*buf = (*buf)[:len(*buf)-1]
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// LongestPrefixOf produces the longest member of the set that is a prefix of s.
+// The boolean result is false if there is no such member.
+// The set may be nil.
+func (t *Trie[T]) LongestPrefixOf(s T) (T, bool) {
+	if t == nil {
+		return "", false
+	}
+	node, best := &t.root, -1
+	if node.member {
+		best = 0
+	}
+	for i := 0; i < len(s); i++ {
+		pos, ok := node.find(s[i])
+		if !ok {
+			break
+		}
+		node = node.edges[pos].child
+		if node.member {
+			best = i + 1
+		}
+	}
+	if best < 0 {
+		return "", false
+	}
+	return s[:best], true
+}
+
+// Slice produces a new slice of the elements in the set,
+// in lexicographic order.
+func (t *Trie[T]) Slice() []T {
+	if t.Len() == 0 {
+		return nil
+	}
+	result := make([]T, 0, t.Len())
+	for val := range t.All() {
+		result = append(result, val)
+	}
+	return result
+}
+
+// Set produces a new [Of] with the same members as t.
+// The set may be nil.
+func (t *Trie[T]) Set() Of[T] {
+	return Collect(t.All())
+}
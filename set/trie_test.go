@@ -0,0 +1,67 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTrie(t *testing.T) {
+	tr := NewTrie("car", "cart", "cat", "dog", "", "ca", "car")
+	if tr.Len() != 6 {
+		t.Errorf("got len %d, want 6", tr.Len())
+	}
+	if got := tr.Slice(); !slices.Equal(got, []string{"", "ca", "car", "cart", "cat", "dog"}) {
+		t.Errorf("got %v, want [ ca car cart cat dog]", got)
+	}
+	if !tr.Has("cart") || tr.Has("c") || tr.Has("carts") {
+		t.Error("wrong membership")
+	}
+
+	if got := slices.Collect(tr.WithPrefix("car")); !slices.Equal(got, []string{"car", "cart"}) {
+		t.Errorf("got %v, want [car cart]", got)
+	}
+	if got := slices.Collect(tr.WithPrefix("x")); len(got) != 0 {
+		t.Errorf("got %v, want none", got)
+	}
+
+	cases := []struct {
+		s, want string
+		ok      bool
+	}{
+		{"carton", "cart", true},
+		{"cab", "ca", true},
+		{"cat", "cat", true},
+		{"do", "", true},
+		{"x", "", true},
+	}
+	for _, tc := range cases {
+		got, ok := tr.LongestPrefixOf(tc.s)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("LongestPrefixOf(%q): got %q (%v), want %q (%v)", tc.s, got, ok, tc.want, tc.ok)
+		}
+	}
+
+	tr.Del("", "cart", "nope", "c")
+	if _, ok := tr.LongestPrefixOf("x"); ok {
+		t.Error("LongestPrefixOf(x) should fail after deleting the empty string")
+	}
+	if got := tr.Slice(); !slices.Equal(got, []string{"ca", "car", "cat", "dog"}) {
+		t.Errorf("got %v, want [ca car cat dog]", got)
+	}
+	tr.Del("ca", "car", "cat")
+	if len(tr.root.edges) != 1 {
+		t.Errorf("got %d root edges after deletion, want 1", len(tr.root.edges))
+	}
+
+	if !tr.Set().Equal(New("dog")) || !TrieOf(New("dog")).Equal(tr) {
+		t.Error("conversion to or from Of failed")
+	}
+
+	var empty *Trie[string]
+	if empty.Len() != 0 || empty.Has("") || empty.Slice() != nil {
+		t.Error("nil set should be empty")
+	}
+	if _, ok := empty.LongestPrefixOf("a"); ok {
+		t.Error("nil set should have no prefixes")
+	}
+}